@@ -0,0 +1,64 @@
+package secondly
+
+import (
+	"context"
+)
+
+// background runs a goroutine until the manager is closed. Close calls stop
+// to make run return. It returns false and doesn't run anything if the manager
+// is already closed.
+func (m *Manager) background(run func(), stop func(ctx context.Context) error) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return false
+	}
+	m.closers = append(m.closers, stop)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		run()
+	}()
+
+	return true
+}
+
+// Close stops handling signals and file system events and gracefully shuts
+// down the web server. It waits for reloads and callbacks that are in
+// progress, or until the context is done. Once closed, a manager doesn't start
+// background goroutines anymore, but the config could still be read.
+func (m *Manager) Close(ctx context.Context) error {
+	m.mu.Lock()
+	m.closed = true
+	closers := m.closers
+	m.closers = nil
+	m.mu.Unlock()
+
+	var err error
+	for _, stop := range closers {
+		if serr := stop(ctx); serr != nil && err == nil {
+			err = serr
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop closes the manager, waiting for reloads and callbacks in progress
+// without a deadline.
+func (m *Manager) Stop() {
+	if err := m.Close(context.Background()); err != nil {
+		m.reportError(err)
+	}
+}
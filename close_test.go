@@ -0,0 +1,85 @@
+package secondly
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestClose(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "one"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	// Package os/signal starts a goroutine of its own that never exits
+	other := New()
+	other.HandleSIGHUP()
+	other.Stop()
+	goroutines := runtime.NumGoroutine()
+
+	m, _ := startedManager(t, f, (*Manager).HandleFileSystemEvents)
+	m.PollFiles(10 * time.Millisecond)
+	m.HandleSIGHUP()
+	m.StartServer("127.0.0.1", 0)
+	m.Stop()
+
+	if err := ioutil.WriteFile(f, []byte(`{"app_name": "two"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if name := appName(m); name != "one" {
+		t.Errorf("Expected no reloads after close, got %q", name)
+	}
+	waitFor(t, "background goroutines to exit", func() bool {
+		return runtime.NumGoroutine() <= goroutines
+	})
+
+	// Closed manager doesn't start anything
+	m.PollFiles(10 * time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	if name := appName(m); name != "one" {
+		t.Errorf("Expected no reloads after close, got %q", name)
+	}
+}
+
+func TestCloseWaitsForCallbacks(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "one"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	called := make(chan struct{})
+	release := make(chan struct{})
+	m.OnChange("app_name", func(_, _ interface{}) {
+		close(called)
+		<-release
+	})
+	m.PollFiles(10 * time.Millisecond)
+
+	if err := ioutil.WriteFile(f, []byte(`{"app_name": "two"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-called:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for callback")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := m.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected close to time out while callback is running, got %v", err)
+	}
+
+	close(release)
+	if err := m.Close(context.Background()); err != nil {
+		t.Errorf("Expected close to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+// Command secondly-encrypt encrypts values for Secondly config files.
+//
+// Usage:
+//
+//	secondly-encrypt -genkey > secret.key
+//	secondly-encrypt -key-file secret.key 'database password'
+//	echo -n 'database password' | secondly-encrypt -key-env APP_CONFIG_KEY
+//
+// The output looks like "enc:v1:..." and can be used as a value of a string
+// field in a config file.
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/localhots/secondly"
+)
+
+func main() {
+	log.SetFlags(0)
+
+	genKey := flag.Bool("genkey", false, "Print a new random key and exit")
+	keyFile := flag.String("key-file", "", "Path to a file with a base64 encoded key")
+	keyEnv := flag.String("key-env", "", "Name of an environment variable with a base64 encoded key")
+	decrypt := flag.Bool("decrypt", false, "Decrypt the value instead")
+	flag.Parse()
+
+	if *genKey {
+		// 32 bytes select AES-256
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Println(base64.StdEncoding.EncodeToString(key))
+		return
+	}
+
+	var keys secondly.KeyProvider
+	switch {
+	case *keyFile != "" && *keyEnv == "":
+		keys = secondly.KeyFile(*keyFile)
+	case *keyEnv != "" && *keyFile == "":
+		keys = secondly.KeyEnv(*keyEnv)
+	default:
+		log.Fatalln("Exactly one of -key-file and -key-env must be set")
+	}
+
+	// Value is read from stdin if it is not given as an argument, so that it
+	// doesn't end up in shell history
+	var value string
+	switch flag.NArg() {
+	case 0:
+		body, err := ioutil.ReadAll(bufio.NewReader(os.Stdin))
+		if err != nil {
+			log.Fatalln(err)
+		}
+		value = strings.TrimSuffix(string(body), "\n")
+	case 1:
+		value = flag.Arg(0)
+	default:
+		log.Fatalln("Too many arguments, quote the value")
+	}
+
+	var out string
+	var err error
+	if *decrypt {
+		out, err = secondly.Decrypt(keys, value)
+	} else {
+		out, err = secondly.Encrypt(keys, value)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Println(out)
+}
@@ -0,0 +1,229 @@
+package secondly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// Codec converts config structs to and from a file format. Custom formats can
+// be plugged in with RegisterCodec.
+type Codec interface {
+	// Decode fills the struct target points to with values from body. Values
+	// missing from body must be left untouched.
+	Decode(body []byte, target interface{}) error
+	// Encode serializes a config struct.
+	Encode(obj interface{}) ([]byte, error)
+	// Tag returns the name of the struct tag that holds field names. It is
+	// used to build field paths for callbacks and the web GUI.
+	Tag() string
+	// Extensions returns file extensions of the format, including the dot.
+	Extensions() []string
+}
+
+// TreeCodec is a Codec that also converts files to and from generic trees of
+// map[string]interface{}, []interface{} and scalar values. Trees are used to
+// find unknown keys, to tell which fields of optional sections the files set
+// and to write only the changed values to override layers. Built-in codecs
+// implement it.
+//
+// With codecs that don't implement it unknown keys are not checked, fields of
+// optional sections that have zero values get their defaults, and the whole
+// config is written to override layers.
+type TreeCodec interface {
+	Codec
+	// DecodeTree decodes body into a tree.
+	DecodeTree(body []byte) (map[string]interface{}, error)
+	// EncodeTree serializes a tree.
+	EncodeTree(tree map[string]interface{}) ([]byte, error)
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[string]Codec{
+		"json": jsonCodec{},
+		"yaml": yamlCodec{},
+		"toml": tomlCodec{},
+	}
+)
+
+// RegisterCodec makes a codec available under the given format name. It
+// replaces any codec previously registered with the same name.
+func RegisterCodec(name string, c Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+
+	codecs[name] = c
+}
+
+// findCodec returns a codec for the given format. If format is empty it is
+// guessed from the file extension, falling back to JSON.
+func findCodec(format, file string) (Codec, error) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+
+	if format == "" {
+		ext := strings.ToLower(filepath.Ext(file))
+		for name, c := range codecs {
+			for _, cext := range c.Extensions() {
+				if strings.ToLower(cext) == ext {
+					format = name
+				}
+			}
+		}
+	}
+	if format == "" {
+		format = "json"
+	}
+
+	if c, ok := codecs[format]; ok {
+		return c, nil
+	}
+
+	return nil, fmt.Errorf("Unknown config format %q", format)
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Decode(body []byte, target interface{}) error {
+	return decodeJSON(body, target)
+}
+
+func (jsonCodec) Encode(obj interface{}) ([]byte, error) {
+	body, err := encodeJSON(obj)
+	if err != nil {
+		return nil, err
+	}
+	out := bytes.NewBuffer([]byte{})
+
+	// Indent with empty prefix and four spaces
+	if err = json.Indent(out, body, "", "    "); err != nil {
+		return nil, err
+	}
+
+	// Adding a trailing newline
+	// It's good for your carma
+	out.WriteByte('\n')
+
+	return out.Bytes(), nil
+}
+
+func (jsonCodec) DecodeTree(body []byte) (map[string]interface{}, error) {
+	var tree map[string]interface{}
+	if err := json.Unmarshal(body, &tree); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+func (c jsonCodec) EncodeTree(tree map[string]interface{}) ([]byte, error) {
+	return c.Encode(tree)
+}
+
+func (jsonCodec) Tag() string {
+	return "json"
+}
+
+func (jsonCodec) Extensions() []string {
+	return []string{".json"}
+}
+
+type yamlCodec struct{}
+
+func (yamlCodec) Decode(body []byte, target interface{}) error {
+	return yaml.Unmarshal(body, target)
+}
+
+func (yamlCodec) Encode(obj interface{}) ([]byte, error) {
+	return yaml.Marshal(obj)
+}
+
+func (yamlCodec) DecodeTree(body []byte) (map[string]interface{}, error) {
+	var tree map[string]interface{}
+	if err := yaml.Unmarshal(body, &tree); err != nil {
+		return nil, err
+	}
+
+	return stringKeys(tree).(map[string]interface{}), nil
+}
+
+func (c yamlCodec) EncodeTree(tree map[string]interface{}) ([]byte, error) {
+	return c.Encode(tree)
+}
+
+// stringKeys replaces maps with interface keys that YAML decodes nested
+// objects into with maps with string keys.
+func stringKeys(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			v[key] = stringKeys(elem)
+		}
+		if v == nil {
+			return map[string]interface{}{}
+		}
+	case map[interface{}]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			obj[fmt.Sprint(key)] = stringKeys(elem)
+		}
+		return obj
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = stringKeys(elem)
+		}
+	}
+
+	return val
+}
+
+func (yamlCodec) Tag() string {
+	return "yaml"
+}
+
+func (yamlCodec) Extensions() []string {
+	return []string{".yaml", ".yml"}
+}
+
+type tomlCodec struct{}
+
+func (tomlCodec) Decode(body []byte, target interface{}) error {
+	return toml.Unmarshal(body, target)
+}
+
+func (tomlCodec) Encode(obj interface{}) ([]byte, error) {
+	out := bytes.NewBuffer([]byte{})
+	if err := toml.NewEncoder(out).Encode(obj); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+func (tomlCodec) DecodeTree(body []byte) (map[string]interface{}, error) {
+	var tree map[string]interface{}
+	if err := toml.Unmarshal(body, &tree); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+func (c tomlCodec) EncodeTree(tree map[string]interface{}) ([]byte, error) {
+	return c.Encode(tree)
+}
+
+func (tomlCodec) Tag() string {
+	return "toml"
+}
+
+func (tomlCodec) Extensions() []string {
+	return []string{".toml"}
+}
@@ -0,0 +1,226 @@
+package secondly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type yamlConf struct {
+	AppName  string `yaml:"app_name"`
+	Database struct {
+		Port int `yaml:"port"`
+	} `yaml:"database"`
+}
+
+type tomlConf struct {
+	AppName  string `toml:"app_name"`
+	Database struct {
+		Port int `toml:"port"`
+	} `toml:"database"`
+}
+
+func TestFindCodec(t *testing.T) {
+	tests := []struct {
+		format, file, tag string
+	}{
+		{"", "config.json", "json"},
+		{"", "config.yaml", "yaml"},
+		{"", "/etc/app/config.YML", "yaml"},
+		{"", "config.toml", "toml"},
+		{"", "config", "json"},
+		{"yaml", "config.conf", "yaml"},
+	}
+	for _, test := range tests {
+		c, err := findCodec(test.format, test.file)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", test.file, err)
+			continue
+		}
+		if c.Tag() != test.tag {
+			t.Errorf("Expected %q to use %s codec, got %s", test.file, test.tag, c.Tag())
+		}
+	}
+
+	if _, err := findCodec("xml", "config.xml"); err == nil {
+		t.Error("Expected an error for unknown format")
+	}
+}
+
+func TestYAMLConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(file, []byte("app_name: Secondly\ndatabase:\n  port: 3306\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var conf yamlConf
+	m := testManager(file)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.AppName != "Secondly" || conf.Database.Port != 3306 {
+		t.Errorf("Config was not loaded correctly: %+v", conf)
+	}
+
+	var newPort interface{}
+	m.OnChange("database.port", func(oldVal, newVal interface{}) {
+		newPort = newVal
+	})
+	if err := m.updateConfig([]byte("database:\n  port: 5432\n")); err != nil {
+		t.Fatal(err)
+	}
+	if newPort != 5432 {
+		t.Errorf("Expected database.port callback to receive 5432, got %v", newPort)
+	}
+
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "app_name: Secondly\ndatabase:\n  port: 5432\n"; string(body) != exp {
+		t.Errorf("Expected config to be written as:\n%s\ngot:\n%s", exp, body)
+	}
+}
+
+func TestTOMLConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.toml")
+	if err := ioutil.WriteFile(file, []byte("app_name = \"Secondly\"\n\n[database]\nport = 3306\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var conf tomlConf
+	m := testManager(file)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.AppName != "Secondly" || conf.Database.Port != 3306 {
+		t.Errorf("Config was not loaded correctly: %+v", conf)
+	}
+
+	var newPort interface{}
+	m.OnChange("database.port", func(oldVal, newVal interface{}) {
+		newPort = newVal
+	})
+	if err := m.updateConfig([]byte("[database]\nport = 5432\n")); err != nil {
+		t.Fatal(err)
+	}
+	if newPort != 5432 {
+		t.Errorf("Expected database.port callback to receive 5432, got %v", newPort)
+	}
+
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+	var written tomlConf
+	body, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (tomlCodec{}).Decode(body, &written); err != nil {
+		t.Fatal(err)
+	}
+	if written != conf {
+		t.Errorf("Expected written config to equal %+v, got %+v", conf, written)
+	}
+}
+
+// kvCodec is a toy format of KEY=value lines mapped onto string fields.
+type kvCodec struct{}
+
+func (kvCodec) Decode(body []byte, target interface{}) error {
+	val := reflect.ValueOf(target).Elem()
+	for _, line := range strings.Split(string(body), "\n") {
+		if kv := strings.SplitN(line, "=", 2); len(kv) == 2 {
+			for i := 0; i < val.NumField(); i++ {
+				if val.Type().Field(i).Tag.Get("kv") == kv[0] {
+					val.Field(i).SetString(kv[1])
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (kvCodec) Encode(obj interface{}) ([]byte, error) {
+	var out []string
+	val := reflect.ValueOf(obj).Elem()
+	for i := 0; i < val.NumField(); i++ {
+		out = append(out, val.Type().Field(i).Tag.Get("kv")+"="+val.Field(i).String())
+	}
+
+	return []byte(strings.Join(out, "\n") + "\n"), nil
+}
+
+func (kvCodec) Tag() string {
+	return "kv"
+}
+
+func (kvCodec) Extensions() []string {
+	return []string{".kv"}
+}
+
+// registerKVCodec makes kvCodec available for the duration of the test.
+func registerKVCodec(t *testing.T) {
+	RegisterCodec("kv", kvCodec{})
+	t.Cleanup(func() {
+		codecsMu.Lock()
+		delete(codecs, "kv")
+		codecsMu.Unlock()
+	})
+}
+
+func TestCustomCodec(t *testing.T) {
+	registerKVCodec(t)
+
+	c, err := findCodec("", "app.kv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Tag() != "kv" {
+		t.Fatalf("Expected kv codec to be found by extension, got %s", c.Tag())
+	}
+
+	var conf struct {
+		Host string `kv:"HOST"`
+	}
+	if err := c.Decode([]byte("HOST=localhost\n"), &conf); err != nil {
+		t.Fatal(err)
+	}
+	fields := indexFields(extractFields(&conf, c.Tag(), ""))
+	if f, ok := fields["HOST"]; !ok || f.Value != "localhost" {
+		t.Errorf("Expected HOST field to equal %q, got %v", "localhost", f.Value)
+	}
+}
+
+func TestYAMLTree(t *testing.T) {
+	tree, err := (yamlCodec{}).DecodeTree([]byte("database:\n  port: 3306\nservers:\n  - host: a\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nested objects are decoded into maps with string keys
+	exp := map[string]interface{}{
+		"database": map[string]interface{}{"port": 3306},
+		"servers":  []interface{}{map[string]interface{}{"host": "a"}},
+	}
+	if !reflect.DeepEqual(tree, exp) {
+		t.Errorf("Expected %v, got %v", exp, tree)
+	}
+}
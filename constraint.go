@@ -0,0 +1,223 @@
+package secondly
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// optionsTag is the name of the struct tag that holds Secondly's field options.
+const optionsTag = "secondly"
+
+// constraints describe the values a field may take and how it is shown. They
+// are declared with the secondly struct tag, e.g. `secondly:"required,min=1"`.
+// Since patterns may contain commas, pattern must be the last option in the
+// tag.
+type constraints struct {
+	Required bool     `json:"required,omitempty"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+	Pattern  string   `json:"pattern,omitempty"`
+	Enum     []string `json:"enum,omitempty"`
+	Secret   bool     `json:"secret,omitempty"` // Secret values are masked in the web GUI and logs
+}
+
+// FieldError describes a field value that does not satisfy its constraints.
+type FieldError struct {
+	Path string `json:"path"`
+	Msg  string `json:"msg"`
+}
+
+// ValidationError is returned when one or more fields of a candidate config
+// do not satisfy their constraints.
+type ValidationError []FieldError
+
+func (e ValidationError) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Path + " " + fe.Msg
+	}
+
+	return "Invalid field values: " + strings.Join(msgs, "; ")
+}
+
+// parseTag splits the secondly tag into a map of options. Options without a
+// value are mapped to an empty string.
+func parseTag(tag string) map[string]string {
+	opts := make(map[string]string)
+	for tag != "" {
+		var opt string
+		if strings.HasPrefix(tag, "pattern=") {
+			opt, tag = tag, ""
+		} else if i := strings.Index(tag, ","); i >= 0 {
+			opt, tag = tag[:i], tag[i+1:]
+		} else {
+			opt, tag = tag, ""
+		}
+
+		if i := strings.Index(opt, "="); i >= 0 {
+			opts[opt[:i]] = opt[i+1:]
+		} else if opt != "" {
+			opts[opt] = ""
+		}
+	}
+
+	return opts
+}
+
+func parseConstraints(tag string) constraints {
+	var c constraints
+	opts := parseTag(tag)
+
+	_, c.Required = opts["required"]
+	_, secret := opts["secret"]
+	_, encrypted := opts["encrypted"]
+	c.Secret = secret || encrypted
+	if val, ok := opts["min"]; ok {
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			c.Min = &f
+		}
+	}
+	if val, ok := opts["max"]; ok {
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			c.Max = &f
+		}
+	}
+	if val, ok := opts["enum"]; ok && val != "" {
+		c.Enum = strings.Split(val, "|")
+	}
+	c.Pattern = opts["pattern"]
+
+	return c
+}
+
+// patterns caches compiled patterns by their source, so each pattern is
+// compiled once instead of on every reload and save.
+var patterns sync.Map
+
+// compilePattern returns the compiled form of a pattern from the cache.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(pattern, re)
+
+	return re, nil
+}
+
+// checkPatterns compiles the patterns of every field of the type, including
+// the fields of sections and elements that are not set yet. It returns an
+// error for the first invalid one.
+func checkPatterns(typ reflect.Type, path string, seen map[reflect.Type]bool) error {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice ||
+		typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct || seen[typ] {
+		return nil
+	}
+	seen[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := joinPath(path, field.Name)
+		if pattern := parseConstraints(field.Tag.Get(optionsTag)).Pattern; pattern != "" {
+			if _, err := compilePattern(pattern); err != nil {
+				return fmt.Errorf("Invalid pattern of %s field: %v", name, err)
+			}
+		}
+		if err := checkPatterns(field.Type, name, seen); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// check returns a list of problems with the given value. Min and max limit
+// the length of strings and the value of numbers. Required fields must be
+// set, and required strings must not be empty; false and 0 are valid values
+// of required bools and numbers.
+func (c constraints) check(val interface{}) []string {
+	var errs []string
+
+	// Unset optional fields are only checked for presence
+	if val == nil {
+		if c.Required {
+			errs = append(errs, "is required")
+		}
+		return errs
+	}
+
+	rval := reflect.ValueOf(val)
+	str := fmt.Sprint(formatValue(val))
+	isText := rval.Kind() == reflect.String || isTextType(rval.Type())
+	if c.Required && isText && str == "" {
+		errs = append(errs, "is required")
+	}
+
+	var num float64
+	var isNum bool
+	switch rval.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		num, isNum = float64(rval.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		num, isNum = float64(rval.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		num, isNum = rval.Float(), true
+	case reflect.String:
+		num, isNum = float64(len(rval.String())), true
+	}
+	if isNum && c.Min != nil && num < *c.Min {
+		errs = append(errs, fmt.Sprintf("must be at least %v", *c.Min))
+	}
+	if isNum && c.Max != nil && num > *c.Max {
+		errs = append(errs, fmt.Sprintf("must be at most %v", *c.Max))
+	}
+
+	if c.Pattern != "" {
+		if re, err := compilePattern(c.Pattern); err != nil {
+			errs = append(errs, fmt.Sprintf("has invalid pattern %q", c.Pattern))
+		} else if !re.MatchString(str) {
+			errs = append(errs, fmt.Sprintf("must match pattern %q", c.Pattern))
+		}
+	}
+	if len(c.Enum) > 0 {
+		var found bool
+		for _, opt := range c.Enum {
+			if opt == str {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, "must be one of "+strings.Join(c.Enum, ", "))
+		}
+	}
+
+	return errs
+}
+
+// checkConstraints validates every field of the config against the
+// constraints declared in its tags.
+func checkConstraints(conf interface{}, tagName string) error {
+	var errs ValidationError
+	for _, f := range extractFields(conf, tagName, "") {
+		for _, msg := range f.check(f.Value) {
+			errs = append(errs, FieldError{Path: f.Path, Msg: msg})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
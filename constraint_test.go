@@ -0,0 +1,109 @@
+package secondly
+
+import (
+	"strings"
+	"testing"
+)
+
+type constrainedConf struct {
+	Name    string `json:"name" secondly:"required,pattern=^[a-z]+(,[a-z]+)*$"`
+	Workers int    `json:"workers" secondly:"min=1,max=64"`
+	Adapter string `json:"adapter" secondly:"enum=mysql|postgresql"`
+}
+
+func TestParseTag(t *testing.T) {
+	opts := parseTag("required,min=1,pattern=^a,b$")
+	if _, ok := opts["required"]; !ok {
+		t.Error("Expected required option to be present")
+	}
+	if opts["min"] != "1" {
+		t.Errorf("Expected min to be %q, got %q", "1", opts["min"])
+	}
+	if opts["pattern"] != "^a,b$" {
+		t.Errorf("Expected pattern to be %q, got %q", "^a,b$", opts["pattern"])
+	}
+}
+
+func TestCheckConstraints(t *testing.T) {
+	good := constrainedConf{Name: "foo,bar", Workers: 4, Adapter: "mysql"}
+	if err := checkConstraints(&good, "json"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	bad := constrainedConf{Name: "", Workers: 65, Adapter: "sqlite"}
+	err := checkConstraints(&bad, "json")
+	verr, ok := err.(ValidationError)
+	if !ok {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+
+	paths := make(map[string]int)
+	for _, fe := range verr {
+		paths[fe.Path]++
+	}
+	// Empty name is both missing and not matching the pattern
+	if paths["name"] != 2 {
+		t.Errorf("Expected 2 errors for name, got %d", paths["name"])
+	}
+	if paths["workers"] != 1 {
+		t.Errorf("Expected 1 error for workers, got %d", paths["workers"])
+	}
+	if paths["adapter"] != 1 {
+		t.Errorf("Expected 1 error for adapter, got %d", paths["adapter"])
+	}
+}
+
+func TestFieldConstraints(t *testing.T) {
+	fields := indexFields(extractFields(constrainedConf{}, "json", ""))
+
+	w := fields["workers"]
+	if w.Min == nil || *w.Min != 1 || w.Max == nil || *w.Max != 64 {
+		t.Errorf("Expected workers to be limited to 1..64, got %v..%v", w.Min, w.Max)
+	}
+	if a := fields["adapter"]; len(a.Enum) != 2 {
+		t.Errorf("Expected adapter to have 2 options, got %v", a.Enum)
+	}
+	if n := fields["name"]; !n.Required {
+		t.Error("Expected name to be required")
+	}
+}
+
+type requiredConf struct {
+	Debug   bool    `json:"debug" secondly:"required"`
+	Workers int     `json:"workers" secondly:"required"`
+	Name    string  `json:"name" secondly:"required"`
+	Host    *string `json:"host" secondly:"required"`
+}
+
+func TestRequiredConstraints(t *testing.T) {
+	err := checkConstraints(&requiredConf{}, "json")
+	verr, ok := err.(ValidationError)
+	if !ok {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+
+	paths := make(map[string]bool)
+	for _, fe := range verr {
+		paths[fe.Path] = true
+	}
+	// False and 0 are valid values, empty strings and unset fields are not
+	if paths["debug"] || paths["workers"] {
+		t.Errorf("Expected false and 0 to satisfy required, got %v", verr)
+	}
+	if !paths["name"] || !paths["host"] {
+		t.Errorf("Expected empty name and unset host to be reported, got %v", verr)
+	}
+}
+
+type badPatternConf struct {
+	Servers []struct {
+		Host string `json:"host" secondly:"pattern=^[a-z+$"`
+	} `json:"servers"`
+}
+
+func TestInvalidPattern(t *testing.T) {
+	err := New().Manage(&badPatternConf{})
+	if err == nil || !strings.Contains(err.Error(), "Invalid pattern of Servers.Host field") {
+		t.Errorf("Expected invalid pattern error, got %v", err)
+	}
+}
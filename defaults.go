@@ -0,0 +1,152 @@
+package secondly
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// defaultTag is the name of the struct tag that holds a default field value.
+const defaultTag = "default"
+
+// Defaulter is implemented by configuration structs that set their own
+// default values. Defaults is called after default tags are applied, so it can
+// override them or compute values that can't be expressed in a tag.
+type Defaulter interface {
+	Defaults()
+}
+
+// makeDefaults builds the default config. Values of the struct passed to
+// Manage are used as a base, then default tags and the Defaults method are
+// applied on top of them. Fields are found with the tag of the config codec.
+func makeDefaults(target interface{}, tagName string) (interface{}, error) {
+	defs := duplicate(target)
+
+	var err error
+	walkFields(reflect.ValueOf(defs), tagName, "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		str, ok := ftyp.Tag.Lookup(defaultTag)
+		if !ok || err != nil || isElement(ftyp, fval) {
+			return
+		}
+		if perr := setFromString(fval, str); perr != nil {
+			err = fmt.Errorf("Invalid default value of %s field: %w", ftyp.Name, perr)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if d, ok := defs.(Defaulter); ok {
+		d.Defaults()
+	}
+
+	return defs, nil
+}
+
+// applySectionDefaults applies defaults to optional sections allocated by
+// decoders. Decoders start them with zero values, so fields of such sections
+// that are not set in any of the config files get the defaults of the section
+// type. Files of codecs that can't decode generic trees don't tell which
+// fields they set, so zero fields are taken as unset.
+func (m *Manager) applySectionDefaults(conf interface{}, layers []layer, bodies [][]byte) error {
+	tagName := m.codec().Tag()
+	before := containerValues(m.defaultConfig(), tagName)
+	defaults := make(map[string]reflect.Value)
+	var err error
+	noop := func(string, reflect.StructField, reflect.Value) {}
+	walkTree(reflect.ValueOf(conf), tagName, "", noop, func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		if err != nil || fval.Kind() != reflect.Ptr || fval.IsNil() {
+			return
+		}
+		if bval, ok := before[path]; ok && !bval.IsNil() {
+			return
+		}
+
+		var defs interface{}
+		if defs, err = makeDefaults(reflect.New(fval.Type().Elem()).Interface(), tagName); err != nil {
+			return
+		}
+		walkFields(reflect.ValueOf(defs), tagName, path, func(path string, ftyp reflect.StructField, fval reflect.Value) {
+			defaults[path] = fval
+		})
+	})
+	if err != nil || len(defaults) == 0 {
+		return err
+	}
+
+	trees, err := decodeTrees(layers, bodies)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]bool)
+	var opaque bool
+	for i, tree := range trees {
+		if tree != nil {
+			collectKeys(tree, "", keys)
+		} else if bodies[i] != nil {
+			opaque = true
+		}
+	}
+	// TOML decoder matches keys case-insensitively
+	norm := func(path string) string { return path }
+	if tagName == "toml" {
+		norm = strings.ToLower
+		lower := make(map[string]bool, len(keys))
+		for key, leaf := range keys {
+			lower[norm(key)] = leaf
+		}
+		keys = lower
+	}
+	isSet := func(path string) bool {
+		tokens := splitPath(path)
+		for i := range tokens {
+			prefix := joinTokens(tokens[:i+1])
+			if leaf, ok := keys[norm(prefix)]; ok && (leaf || prefix == path) {
+				return true
+			}
+		}
+		return false
+	}
+
+	walkFields(reflect.ValueOf(conf), tagName, "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		if dval, ok := defaults[path]; ok && !isSet(path) && !(opaque && !fval.IsZero()) {
+			fval.Set(deepCopy(dval))
+		}
+	})
+
+	return nil
+}
+
+// collectKeys records paths of all keys of a nested map. Keys of nested maps
+// are recorded as false and keys of other values as true.
+func collectKeys(obj interface{}, path string, keys map[string]bool) {
+	add := func(key string, val interface{}) {
+		kpath := joinPath(path, key)
+		switch val.(type) {
+		case map[string]interface{}:
+			keys[kpath] = false
+			collectKeys(val, kpath, keys)
+		default:
+			keys[kpath] = true
+		}
+	}
+
+	if v, ok := obj.(map[string]interface{}); ok {
+		for key, val := range v {
+			add(key, val)
+		}
+	}
+}
+
+// defaultConfig returns a copy of the default config.
+func (m *Manager) defaultConfig() interface{} {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return duplicate(m.defaults)
+}
+
+// resetToDefaults replaces all values of the config with defaults.
+func (m *Manager) resetToDefaults(conf interface{}) {
+	reflect.ValueOf(conf).Elem().Set(reflect.ValueOf(m.defaultConfig()).Elem())
+}
@@ -0,0 +1,180 @@
+package secondly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type defaultsConf struct {
+	Host    string `json:"host" default:"localhost"`
+	Port    int    `json:"port" default:"3306"`
+	Debug   bool   `json:"debug"`
+	Workers int    `json:"workers"`
+}
+
+func (c *defaultsConf) Defaults() {
+	c.Workers = 4
+}
+
+func TestMakeDefaults(t *testing.T) {
+	defs, err := makeDefaults(&defaultsConf{Debug: true}, "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := defaultsConf{Host: "localhost", Port: 3306, Debug: true, Workers: 4}
+	if c := *defs.(*defaultsConf); c != exp {
+		t.Errorf("Expected defaults to equal %+v, got %+v", exp, c)
+	}
+
+	var bad struct {
+		Port int `json:"port" default:"many"`
+	}
+	if _, err := makeDefaults(&bad, "json"); err == nil {
+		t.Error("Expected an error for invalid default value")
+	}
+}
+
+func TestRemovedKeyRevertsToDefault(t *testing.T) {
+	f := tempConfig(t, `{"host": "example.com", "port": 5432, "workers": 8}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf defaultsConf
+	m := testManager(f)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Host != "example.com" || conf.Port != 5432 || conf.Workers != 8 {
+		t.Errorf("Config was not loaded correctly: %+v", conf)
+	}
+
+	if err := ioutil.WriteFile(f, []byte(`{"host": "example.com"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.readConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Port != 3306 || conf.Workers != 4 {
+		t.Errorf("Expected removed keys to revert to defaults, got %+v", conf)
+	}
+}
+
+func TestDefaultsOfFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(f, []byte("other: 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var conf struct {
+		Y     int `json:"-" yaml:"y" default:"7"`
+		Other int `json:"other" yaml:"other"`
+	}
+	m := testManager(f)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Y != 7 {
+		t.Errorf("Expected default to be applied with yaml tags, got %d", conf.Y)
+	}
+}
+
+type sectionDefaultsConf struct {
+	DB *sectionDBConf `json:"db" yaml:"db" toml:"db"`
+}
+
+type sectionDBConf struct {
+	Host string `json:"host" yaml:"host" toml:"host" default:"localhost"`
+	Port int    `json:"port" yaml:"port" toml:"port" default:"5432"`
+}
+
+func TestSectionDefaults(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := map[string]string{
+		"config.yaml":  "db: {}\n",
+		"config.json":  `{"db": {"host": "example.com"}}`,
+		"config.toml":  "[db]\nhost = \"example.com\"\n",
+		"config2.yaml": "other: 1\n",
+	}
+	for name, body := range tests {
+		f := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(f, []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		var conf sectionDefaultsConf
+		m := testManager(f)
+		if err := m.Manage(&conf); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		switch {
+		case name == "config2.yaml":
+			if conf.DB != nil {
+				t.Errorf("%s: Expected unset section to stay nil, got %+v", name, conf.DB)
+			}
+		case conf.DB == nil || conf.DB.Port != 5432:
+			t.Errorf("%s: Expected section defaults to apply, got %+v", name, conf.DB)
+		case name != "config.yaml" && conf.DB.Host != "example.com":
+			t.Errorf("%s: Expected host from the file, got %q", name, conf.DB.Host)
+		case name == "config.yaml" && conf.DB.Host != "localhost":
+			t.Errorf("%s: Expected default host, got %q", name, conf.DB.Host)
+		}
+	}
+}
+
+// opaqueCodec is JSON that can't decode generic trees.
+type opaqueCodec struct{}
+
+func (opaqueCodec) Decode(body []byte, target interface{}) error {
+	return jsonCodec{}.Decode(body, target)
+}
+
+func (opaqueCodec) Encode(obj interface{}) ([]byte, error) {
+	return jsonCodec{}.Encode(obj)
+}
+
+func (opaqueCodec) Tag() string {
+	return "json"
+}
+
+func (opaqueCodec) Extensions() []string {
+	return []string{".ojson"}
+}
+
+func TestSectionDefaultsCustomCodec(t *testing.T) {
+	RegisterCodec("ojson", opaqueCodec{})
+	defer func() {
+		codecsMu.Lock()
+		delete(codecs, "ojson")
+		codecsMu.Unlock()
+	}()
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "config.ojson")
+	if err := ioutil.WriteFile(f, []byte(`{"db": {"host": "example.com"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Zero fields are taken as unset
+	var conf sectionDefaultsConf
+	if err := testManager(f).Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.DB == nil || conf.DB.Host != "example.com" || conf.DB.Port != 5432 {
+		t.Errorf("Expected section defaults to apply, got %+v", conf.DB)
+	}
+}
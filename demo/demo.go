@@ -32,13 +32,19 @@ func main() {
 	flag.Parse()
 
 	// Delegating configuration management to Secondly
-	secondly.Manage(&conf)
+	if err := secondly.Manage(&conf); err != nil {
+		log.Fatalln(err)
+	}
 	// Handling file system events
-	secondly.HandleFSEvents()
+	secondly.HandleFileSystemEvents()
 	// Handle SIGHUP
 	secondly.HandleSIGHUP()
 	// Starting a web server
 	secondly.StartServer("", 5500)
+	// Reporting reload errors
+	secondly.OnError(func(err error) {
+		log.Println("Failed to reload config:", err)
+	})
 	// Defining callbacks
 	secondly.OnChange("app_name", func(o, n interface{}) {
 		log.Printf("OMG! AppName changed from %q to %q", o, n)
@@ -0,0 +1,258 @@
+package secondly
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// encryptedPrefix marks encrypted values in config files. The rest of the
+// value is base64 encoded AES-GCM nonce followed by the ciphertext.
+const encryptedPrefix = "enc:v1:"
+
+var errNoKeyProvider = errors.New("Config has encrypted values, but no key provider is set")
+
+// KeyProvider supplies the key used to encrypt and decrypt config values. The
+// key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+type KeyProvider interface {
+	Key() ([]byte, error)
+}
+
+// KeyFunc is an adapter to use ordinary functions as key providers.
+type KeyFunc func() ([]byte, error)
+
+// Key calls f().
+func (f KeyFunc) Key() ([]byte, error) {
+	return f()
+}
+
+// KeyFile returns a key provider that reads a base64 encoded key from a file.
+func KeyFile(path string) KeyProvider {
+	return KeyFunc(func() ([]byte, error) {
+		body, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return decodeKey(string(body))
+	})
+}
+
+// KeyEnv returns a key provider that reads a base64 encoded key from an
+// environment variable.
+func KeyEnv(name string) KeyProvider {
+	return KeyFunc(func() ([]byte, error) {
+		str, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, fmt.Errorf("Environment variable %s is not set", name)
+		}
+
+		return decodeKey(str)
+	})
+}
+
+func decodeKey(str string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(str))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid key: %w", err)
+	}
+
+	return key, nil
+}
+
+// Encryption enables encrypted config values. Values like "enc:v1:..." are
+// decrypted when the config is loaded and encrypted again when the web GUI
+// saves the config, so plaintext values never get into config files. Fields
+// tagged with `secondly:"encrypted"` are always encrypted when saved.
+func Encryption(keys KeyProvider) Option {
+	return func(m *Manager) {
+		m.keys = keys
+	}
+}
+
+// Encrypt encrypts a value so that it could be put into a config file.
+func Encrypt(keys KeyProvider, plaintext string) (string, error) {
+	key, err := keys.Key()
+	if err != nil {
+		return "", err
+	}
+
+	return encryptValue(key, plaintext)
+}
+
+// Decrypt decrypts a value encrypted with Encrypt.
+func Decrypt(keys KeyProvider, value string) (string, error) {
+	key, err := keys.Key()
+	if err != nil {
+		return "", err
+	}
+
+	return decryptValue(key, value)
+}
+
+func encryptValue(key []byte, plaintext string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func decryptValue(key []byte, value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return "", errors.New("Value is not encrypted")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedPrefix))
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("Encrypted value is too short")
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// key returns the encryption key. Key provider is called every time, so that
+// keys could be rotated without restarting the app.
+func (m *Manager) key() ([]byte, error) {
+	m.mu.RLock()
+	keys := m.keys
+	m.mu.RUnlock()
+	if keys == nil {
+		return nil, errNoKeyProvider
+	}
+
+	return keys.Key()
+}
+
+// decryptFields replaces encrypted string values with plaintext. It returns
+// paths of the fields that were encrypted.
+func (m *Manager) decryptFields(conf interface{}) (map[string]bool, error) {
+	var key []byte
+	var err error
+	paths := make(map[string]bool)
+	walkFields(reflect.ValueOf(conf), m.codec().Tag(), "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		val := reflect.Indirect(fval)
+		if err != nil || val.Kind() != reflect.String || !strings.HasPrefix(val.String(), encryptedPrefix) {
+			return
+		}
+		if key == nil {
+			if key, err = m.key(); err != nil {
+				return
+			}
+		}
+
+		plaintext, derr := decryptValue(key, val.String())
+		if derr != nil {
+			err = fmt.Errorf("Failed to decrypt %s: %w", path, derr)
+			return
+		}
+		val.SetString(plaintext)
+		paths[path] = true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// encryptedFields returns paths of the fields that have to be encrypted when
+// the config is written: ones that were encrypted in config files and ones
+// tagged with the encrypted option.
+func (m *Manager) encryptedFields(conf interface{}) map[string]bool {
+	m.mu.RLock()
+	paths := make(map[string]bool, len(m.encrypted))
+	for path := range m.encrypted {
+		paths[path] = true
+	}
+	m.mu.RUnlock()
+
+	walkFields(reflect.ValueOf(conf), m.codec().Tag(), "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		if _, ok := parseTag(ftyp.Tag.Get(optionsTag))["encrypted"]; ok {
+			paths[path] = true
+		}
+	})
+
+	return paths
+}
+
+// encryptValues encrypts values of the given fields. Values are indexed by
+// field path. Empty strings are left as is.
+func (m *Manager) encryptValues(values map[string]interface{}, paths map[string]bool) error {
+	var key []byte
+	for path, val := range values {
+		str, ok := val.(string)
+		if !ok || str == "" || !paths[path] {
+			continue
+		}
+		if key == nil {
+			var err error
+			if key, err = m.key(); err != nil {
+				return err
+			}
+		}
+
+		enc, err := encryptValue(key, str)
+		if err != nil {
+			return fmt.Errorf("Failed to encrypt %s: %w", path, err)
+		}
+		values[path] = enc
+	}
+
+	return nil
+}
+
+// encryptFields encrypts string values of the given fields of a config.
+func (m *Manager) encryptFields(conf interface{}, paths map[string]bool) error {
+	values := make(map[string]interface{})
+	walkFields(reflect.ValueOf(conf), m.codec().Tag(), "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		if val := reflect.Indirect(fval); paths[path] && val.Kind() == reflect.String {
+			values[path] = val.String()
+		}
+	})
+	if err := m.encryptValues(values, paths); err != nil {
+		return err
+	}
+
+	walkFields(reflect.ValueOf(conf), m.codec().Tag(), "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		if str, ok := values[path].(string); ok {
+			reflect.Indirect(fval).SetString(str)
+		}
+	})
+
+	return nil
+}
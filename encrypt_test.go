@@ -0,0 +1,203 @@
+package secondly
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var testKey = KeyFunc(func() ([]byte, error) {
+	return bytes.Repeat([]byte{42}, 32), nil
+})
+
+type encryptedConf struct {
+	User     string `json:"user"`
+	Password string `json:"password"`
+	Token    string `json:"token" secondly:"encrypted"`
+}
+
+func TestEncrypt(t *testing.T) {
+	enc, err := Encrypt(testKey, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(enc, encryptedPrefix) || strings.Contains(enc, "hunter2") {
+		t.Errorf("Unexpected encrypted value %q", enc)
+	}
+	if dec, err := Decrypt(testKey, enc); err != nil || dec != "hunter2" {
+		t.Errorf("Expected value to decrypt to hunter2, got %q, %v", dec, err)
+	}
+
+	other := KeyFunc(func() ([]byte, error) {
+		return bytes.Repeat([]byte{1}, 32), nil
+	})
+	if _, err := Decrypt(other, enc); err == nil {
+		t.Error("Expected decryption with a wrong key to fail")
+	}
+
+	os.Setenv("SECONDLY_TEST_KEY", base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{42}, 32)))
+	defer os.Unsetenv("SECONDLY_TEST_KEY")
+	if dec, err := Decrypt(KeyEnv("SECONDLY_TEST_KEY"), enc); err != nil || dec != "hunter2" {
+		t.Errorf("Expected key from the environment to decrypt the value, got %q, %v", dec, err)
+	}
+}
+
+func TestEncryptedConfig(t *testing.T) {
+	enc, err := Encrypt(testKey, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := tempConfig(t, `{"user": "root", "password": "`+enc+`"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf encryptedConf
+	if err := testManager(f).Manage(&conf); !errors.Is(err, errNoKeyProvider) {
+		t.Errorf("Expected missing key provider error, got %v", err)
+	}
+
+	m := testManager(f)
+	Encryption(testKey)(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Password != "hunter2" {
+		t.Errorf("Expected password to be decrypted, got %q", conf.Password)
+	}
+
+	conf.User = "admin"
+	conf.Token = "s3cr3t"
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(body, []byte("hunter2")) || bytes.Contains(body, []byte("s3cr3t")) {
+		t.Errorf("Expected plaintext values not to be written, got %s", body)
+	}
+
+	var written encryptedConf
+	if err := json.Unmarshal(body, &written); err != nil {
+		t.Fatal(err)
+	}
+	if written.User != "admin" {
+		t.Errorf("Expected user to be written in plaintext, got %q", written.User)
+	}
+	for _, val := range []string{written.Password, written.Token} {
+		if _, err := Decrypt(testKey, val); err != nil {
+			t.Errorf("Expected %q to be encrypted: %v", val, err)
+		}
+	}
+}
+
+func TestEncryptedLayers(t *testing.T) {
+	enc, err := Encrypt(testKey, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := tempConfig(t, `{"user": "root", "password": "`+enc+`"}`)
+	defer os.RemoveAll(filepath.Dir(base))
+	local := filepath.Join(filepath.Dir(base), "local.json")
+
+	var conf encryptedConf
+	m := testManager(base)
+	Encryption(testKey)(m)
+	if err := m.Manage(&conf, base, local); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unchanged encrypted value of the base layer is not copied
+	conf.User = "admin"
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadFile(local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(body, []byte("password")) {
+		t.Errorf("Expected only changed values in the local layer, got %s", body)
+	}
+}
+
+func TestEncryptedSecrets(t *testing.T) {
+	enc, err := Encrypt(testKey, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := tempConfig(t, `{"user": "root", "password": "`+enc+`"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf encryptedConf
+	var logs bytes.Buffer
+	m := testManager(f)
+	Encryption(testKey)(m)
+	Logger(log.New(&logs, "", 0))(m)
+	m.Validate(func(newConf interface{}) error {
+		if newConf.(*encryptedConf).User == "bad" {
+			return errors.New("Bad user")
+		}
+		return nil
+	})
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	// Decrypted values are masked like secrets
+	rw := httptest.NewRecorder()
+	m.fieldsHandler(rw, httptest.NewRequest("GET", "/fields.json", nil))
+	if strings.Contains(rw.Body.String(), "hunter2") {
+		t.Errorf("Expected password to be masked, got %s", rw.Body.String())
+	}
+	fields := indexFields(formFields(&conf, "json"))
+	if !fields["token"].Secret {
+		t.Error("Expected encrypted field to be secret")
+	}
+	rw = httptest.NewRecorder()
+	m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(`{"password": "********"}`)))
+	if rw.Code != http.StatusOK || conf.Password != "hunter2" {
+		t.Errorf("Expected the mask to keep the password, got %d: %q", rw.Code, conf.Password)
+	}
+
+	reload := func(body string) error {
+		if err := ioutil.WriteFile(f, []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return m.readConfig()
+	}
+	enc, err = Encrypt(testKey, "s3cret2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reload(`{"user": "root", "password": "` + enc + `"}`); err != nil {
+		t.Fatal(err)
+	}
+	if out := logs.String(); strings.Contains(out, "hunter2") || strings.Contains(out, "s3cret2") {
+		t.Errorf("Expected decrypted values not to be logged, got %s", out)
+	}
+
+	// Rejected reload doesn't forget that the password was encrypted
+	if err := reload(`{"user": "bad", "password": "plain"}`); err == nil {
+		t.Fatal("Expected reload to be rejected")
+	}
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(body, []byte("s3cret2")) {
+		t.Errorf("Expected password to stay encrypted, got %s", body)
+	}
+}
@@ -0,0 +1,50 @@
+package secondly
+
+import (
+	"os"
+	"reflect"
+	"strings"
+)
+
+// envTag is the name of the struct tag that sets an explicit environment
+// variable name for a field.
+const envTag = "env"
+
+// envName builds an environment variable name from a field path, e.g.
+// APP_DATABASE_PORT for the database.port path and APP prefix,
+// APP_SERVERS_0_HOST for servers[0].host or APP_LABELS_A_B for labels["a.b"].
+func envName(prefix, path string) string {
+	tokens := splitPath(path)
+	for i, tok := range tokens {
+		tokens[i] = tokenKey(tok)
+	}
+	name := strings.Join(tokens, "_")
+	name = strings.NewReplacer(".", "_", "[", "_", "]", "_", `"`, "_").Replace(name)
+	name = strings.ToUpper(name)
+	if prefix != "" {
+		name = strings.ToUpper(prefix) + "_" + name
+	}
+
+	return name
+}
+
+// applyEnv overrides config values with environment variables. Fields are
+// looked up by their env tag, or by a name derived from the field path if the
+// prefix is set.
+func applyEnv(conf interface{}, tagName, prefix string, pinned map[string]override) error {
+	return overrideFields(conf, tagName, pinned, func(path string, ftyp reflect.StructField, elem bool) (string, string, bool) {
+		var name string
+		if !elem {
+			name = ftyp.Tag.Get(envTag)
+		}
+		if name == "" && prefix != "" {
+			name = envName(prefix, path)
+		}
+		if name == "" {
+			return "", "", false
+		}
+
+		str, ok := os.LookupEnv(name)
+		return str, name + " environment variable", ok
+	})
+}
@@ -0,0 +1,88 @@
+package secondly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type envConf struct {
+	AppName  string           `json:"app_name" env:"SECONDLY_TEST_NAME"`
+	Database testDatabaseConf `json:"database"`
+}
+
+func TestEnvName(t *testing.T) {
+	if name := envName("app", "database.port"); name != "APP_DATABASE_PORT" {
+		t.Errorf("Expected %q, got %q", "APP_DATABASE_PORT", name)
+	}
+	if name := envName("", "database.port"); name != "DATABASE_PORT" {
+		t.Errorf("Expected %q, got %q", "DATABASE_PORT", name)
+	}
+}
+
+func TestEnvOverride(t *testing.T) {
+	os.Setenv("SECONDLY_TEST_NAME", "FromEnv")
+	os.Setenv("TEST_DATABASE_PORT", "5432")
+	defer os.Unsetenv("SECONDLY_TEST_NAME")
+	defer os.Unsetenv("TEST_DATABASE_PORT")
+
+	f := tempConfig(t, `{"app_name": "FromFile", "database": {"port": 3306, "host": "localhost"}}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf envConf
+	m := testManager(f)
+	Env("test")(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.AppName != "FromEnv" {
+		t.Errorf("Expected app name to be set from env, got %q", conf.AppName)
+	}
+	if conf.Database.Port != 5432 {
+		t.Errorf("Expected database port to be set from env, got %d", conf.Database.Port)
+	}
+
+	// Overrides survive reloads
+	if err := m.updateConfig([]byte(`{"database": {"port": 3307, "host": "example.com"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Database.Port != 5432 || conf.Database.Host != "example.com" {
+		t.Errorf("Unexpected config after reload: %+v", conf.Database)
+	}
+
+	// Overridden values are not written to the file
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `"port": 3307`) || strings.Contains(string(body), "FromEnv") {
+		t.Errorf("Environment values leaked into config file:\n%s", body)
+	}
+
+	if err := m.checkPinned(map[string]string{"database.port": "1"}); err == nil {
+		t.Error("Expected pinned field edit to be rejected")
+	}
+	if err := m.checkPinned(map[string]string{"database.host": "db"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestEnvInvalidValue(t *testing.T) {
+	os.Setenv("TEST_DATABASE_PORT", "not a number")
+	defer os.Unsetenv("TEST_DATABASE_PORT")
+
+	f := tempConfig(t, goodJSON)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	Env("test")(m)
+	if err := m.Manage(&conf); err == nil {
+		t.Error("Expected an error for invalid environment value")
+	}
+}
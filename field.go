@@ -1,21 +1,151 @@
 package secondly
 
 import (
-	"log"
+	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 type field struct {
-	Path  string      `json:"path"`
-	Name  string      `json:"name"`
-	Kind  string      `json:"kind"`
-	Value interface{} `json:"value"`
+	Path     string      `json:"path"`
+	Name     string      `json:"name"`
+	Kind     string      `json:"kind"`
+	Value    interface{} `json:"value"`
+	Default  interface{} `json:"default"`             // Default is used when the field is missing from the config
+	PinnedBy string      `json:"pinned_by,omitempty"` // PinnedBy describes an env var or a flag that overrides the value
+	Template []field     `json:"template,omitempty"`  // Template describes element fields of slices, maps and optional sections
+	Optional bool        `json:"optional,omitempty"`  // Optional fields are pointers that may be left unset
+	Unset    bool        `json:"unset,omitempty"`     // Unset is true for optional fields that are nil
+	constraints
 }
 
-func extractFields(st interface{}, path string) []field {
+// extractFields returns a flat list of config fields. Field paths are built
+// with fieldKey from the given struct tag.
+func extractFields(st interface{}, tagName, path string) []field {
 	var res []field
+	walkFields(reflect.ValueOf(st), tagName, path, func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		res = append(res, newField(path, ftyp, fval))
+	})
 
-	val := reflect.ValueOf(st)
+	return res
+}
+
+// formFields returns config fields along with the slices and maps they belong
+// to. Containers come before their elements and carry a template of element
+// fields, so that the web GUI could add new elements.
+func formFields(st interface{}, tagName string) []field {
+	var res []field
+	leaf, container := formVisitors(&res, tagName)
+	walkTree(reflect.ValueOf(st), tagName, "", leaf, container)
+
+	return res
+}
+
+// elemTemplate returns fields of a zero element of a slice or a map. Paths of
+// the fields are relative to the element, the element itself has an empty
+// path.
+func elemTemplate(ftyp reflect.StructField, typ reflect.Type, tagName string) []field {
+	var res []field
+	leaf, container := formVisitors(&res, tagName)
+	walkValue(reflect.New(typ).Elem(), ftyp, tagName, "", leaf, container)
+
+	return res
+}
+
+func formVisitors(res *[]field, tagName string) (leaf, container visitFunc) {
+	leaf = func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		f := newField(path, ftyp, fval)
+		f.Value = formatValue(f.Value)
+		*res = append(*res, f)
+	}
+	container = func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		f := field{Path: path, Name: ftyp.Name, Kind: fval.Kind().String()}
+		if isElement(ftyp, fval) {
+			f.Name = elementName(path)
+		}
+		if fval.Kind() == reflect.Ptr {
+			f.Optional, f.Unset = true, fval.IsNil()
+		}
+		f.Template = elemTemplate(ftyp, fval.Type().Elem(), tagName)
+		*res = append(*res, f)
+	}
+
+	return leaf, container
+}
+
+func newField(path string, ftyp reflect.StructField, fval reflect.Value) field {
+	name := ftyp.Name
+	if isElement(ftyp, fval) {
+		name = elementName(path)
+	}
+
+	optional := fval.Kind() == reflect.Ptr
+	return field{
+		Path:     path,
+		Name:     name,
+		Kind:     fieldKind(derefType(fval.Type())),
+		Value:    leafValue(fval),
+		Optional: optional,
+		Unset:    optional && fval.IsNil(),
+
+		constraints: parseConstraints(ftyp.Tag.Get(optionsTag)),
+	}
+}
+
+// leafValue returns the value of a leaf field. Unset optional fields have no
+// value.
+func leafValue(fval reflect.Value) interface{} {
+	if fval.Kind() == reflect.Ptr {
+		if fval.IsNil() {
+			return nil
+		}
+		return fval.Elem().Interface()
+	}
+
+	return fval.Interface()
+}
+
+// fieldKind returns the kind of a leaf field as reported to the web GUI.
+func fieldKind(typ reflect.Type) string {
+	switch typ {
+	case durationType:
+		return "duration"
+	case timeType:
+		return "time"
+	}
+	if isTextType(typ) {
+		return "string"
+	}
+
+	return typ.Kind().String()
+}
+
+// derefType returns the type a pointer points to.
+func derefType(typ reflect.Type) reflect.Type {
+	if typ.Kind() == reflect.Ptr {
+		return typ.Elem()
+	}
+
+	return typ
+}
+
+// visitFunc is called for config fields found by walkFields. For slice and
+// map elements ftyp is the struct field that holds the container.
+type visitFunc func(path string, ftyp reflect.StructField, fval reflect.Value)
+
+// walkFields calls fun for every supported leaf field of a struct. If val is
+// a pointer, field values passed to fun are settable.
+func walkFields(val reflect.Value, tagName, path string, fun visitFunc) {
+	walkTree(val, tagName, path, fun, nil)
+}
+
+// walkTree calls leaf for every supported leaf field of a struct and
+// container for every slice, map and pointer to a struct. Container is called
+// before its elements are visited, so it may replace the container value.
+// Pointers to scalars are leaves, nil pointers to structs are not traversed.
+func walkTree(val reflect.Value, tagName, path string, leaf, container visitFunc) {
 	if val.Kind() == reflect.Ptr {
 		val = reflect.Indirect(val)
 	}
@@ -23,51 +153,354 @@ func extractFields(st interface{}, path string) []field {
 
 	for i := 0; i < val.NumField(); i++ {
 		ftyp := typ.Field(i)
+		key, ok := fieldKey(ftyp, tagName)
+		if !ok {
+			continue
+		}
+
 		fval := val.Field(i)
-		tag := ftyp.Tag.Get("json")
-
-		switch kind := fval.Kind(); kind {
-		case reflect.Struct:
-			sub := extractFields(fval.Interface(), path+tag+".")
-			res = append(res, sub...)
-		case reflect.Bool,
-			reflect.Int,
-			reflect.Int8,
-			reflect.Int16,
-			reflect.Int32,
-			reflect.Int64,
-			reflect.Uint,
-			reflect.Uint8,
-			reflect.Uint16,
-			reflect.Uint32,
-			reflect.Uint64,
-			reflect.Float32,
-			reflect.Float64,
-			reflect.String:
-			res = append(res, field{
-				Path:  path + tag,
-				Name:  ftyp.Name,
-				Kind:  kind.String(),
-				Value: fval.Interface(),
-			})
+		if key == "" {
+			// Fields of embedded structs are promoted
+			if fval.Kind() == reflect.Ptr {
+				if fval.IsNil() {
+					continue
+				}
+				fval = fval.Elem()
+			}
+			walkTree(fval, tagName, path, leaf, container)
+			continue
+		}
+
+		walkValue(fval, ftyp, tagName, joinPath(path, key), leaf, container)
+	}
+}
+
+// fieldKey returns the config key of a struct field the way encoding/json
+// names it: the name from the given tag with options stripped. Tags of custom
+// codecs fall back to the json tag, while YAML and TOML decoders only read
+// their own tags. Untagged fields are named after the field, lowercased for
+// YAML. Embedded structs without a name in the tag have an empty key, as
+// their fields are promoted; YAML only promotes them with the inline option.
+// It returns false for fields that are skipped: unexported ones and ones
+// tagged with "-".
+func fieldKey(ftyp reflect.StructField, tagName string) (string, bool) {
+	tag, ok := ftyp.Tag.Lookup(tagName)
+	if !ok && tagName != "yaml" && tagName != "toml" {
+		tag = ftyp.Tag.Get("json")
+	}
+	if tag == "-" {
+		return "", false
+	}
+	opts := strings.Split(tag, ",")
+	name := opts[0]
+
+	typ := derefType(ftyp.Type)
+	embedded := ftyp.Anonymous && name == "" && typ.Kind() == reflect.Struct && !isLeafType(typ)
+	if tagName == "yaml" && embedded {
+		embedded = false
+		for _, opt := range opts[1:] {
+			embedded = embedded || opt == "inline"
+		}
+	}
+	if ftyp.PkgPath != "" && !embedded {
+		return "", false
+	}
+	switch {
+	case embedded:
+		return "", true
+	case name != "":
+		return name, true
+	case tagName == "yaml":
+		return strings.ToLower(ftyp.Name), true
+	default:
+		return ftyp.Name, true
+	}
+}
+
+func walkValue(val reflect.Value, ftyp reflect.StructField, tagName, path string, leaf, container visitFunc) {
+	if isLeafType(val.Type()) {
+		leaf(path, ftyp, val)
+		return
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		walkTree(val, tagName, path, leaf, container)
+	case reflect.Slice:
+		if container != nil {
+			container(path, ftyp, val)
+		}
+		for i := 0; i < val.Len(); i++ {
+			walkValue(val.Index(i), ftyp, tagName, indexPath(path, i), leaf, container)
+		}
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			return
+		}
+		if container != nil {
+			container(path, ftyp, val)
+		}
+		for _, key := range mapKeys(val) {
+			// Map elements are not addressable, so a copy is visited and
+			// then put back into the map
+			elem := reflect.New(val.Type().Elem()).Elem()
+			elem.Set(val.MapIndex(key))
+			walkValue(elem, ftyp, tagName, joinPath(path, key.String()), leaf, container)
+			if val.CanSet() {
+				val.SetMapIndex(key, elem)
+			}
+		}
+	case reflect.Ptr:
+		switch elem := val.Type().Elem(); {
+		case isLeafType(elem):
+			leaf(path, ftyp, val)
+		case elem.Kind() == reflect.Struct:
+			if container != nil {
+				container(path, ftyp, val)
+			}
+			if !val.IsNil() {
+				walkTree(val.Elem(), tagName, path, leaf, container)
+			}
+		}
+	}
+}
+
+// unsupportedFields returns messages about fields of types walkTree skips.
+// They are reported once when the config is managed. Fields are named by
+// their Go names, as elements of slices and maps share the field.
+func unsupportedFields(typ reflect.Type, tagName, path string, seen map[reflect.Type]bool) []string {
+	if seen[typ] {
+		return nil
+	}
+	seen[typ] = true
+
+	var msgs []string
+	for i := 0; i < typ.NumField(); i++ {
+		ftyp := typ.Field(i)
+		key, ok := fieldKey(ftyp, tagName)
+		if !ok {
+			continue
+		}
+		name := path
+		if key != "" {
+			name = joinPath(path, ftyp.Name)
+		}
+		msgs = append(msgs, unsupportedType(ftyp.Type, tagName, name, seen)...)
+	}
+
+	return msgs
+}
+
+func unsupportedType(typ reflect.Type, tagName, path string, seen map[reflect.Type]bool) []string {
+	if isLeafType(typ) {
+		return nil
+	}
+
+	switch kind := typ.Kind(); kind {
+	case reflect.Struct:
+		return unsupportedFields(typ, tagName, path, seen)
+	case reflect.Slice:
+		return unsupportedType(typ.Elem(), tagName, path, seen)
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return []string{fmt.Sprintf("Map key type %q not supported for field %q", typ.Key().Kind(), path)}
+		}
+		return unsupportedType(typ.Elem(), tagName, path, seen)
+	case reflect.Ptr:
+		switch elem := typ.Elem(); {
+		case isLeafType(elem):
+			return nil
+		case elem.Kind() == reflect.Struct:
+			return unsupportedFields(elem, tagName, path, seen)
 		default:
-			log.Printf("Field type %q not supported for field %q\n", kind, path+tag)
+			return []string{fmt.Sprintf("Field type %q not supported for field %q", "*"+elem.Kind().String(), path)}
 		}
+	default:
+		return []string{fmt.Sprintf("Field type %q not supported for field %q", kind, path)}
+	}
+}
+
+// isLeafType returns true for types of values that are edited as a whole.
+func isLeafType(typ reflect.Type) bool {
+	if typ == durationType || typ == timeType || isTextType(typ) {
+		return true
 	}
 
-	return res
+	switch typ.Kind() {
+	case reflect.Bool,
+		reflect.Int,
+		reflect.Int8,
+		reflect.Int16,
+		reflect.Int32,
+		reflect.Int64,
+		reflect.Uint,
+		reflect.Uint8,
+		reflect.Uint16,
+		reflect.Uint32,
+		reflect.Uint64,
+		reflect.Float32,
+		reflect.Float64,
+		reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+// mapKeys returns keys of a map with string keys in sorted order.
+func mapKeys(val reflect.Value) []reflect.Value {
+	keys := val.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	return keys
+}
+
+// isElement returns true if the value is an element of a slice or a map
+// rather than the struct field itself.
+func isElement(ftyp reflect.StructField, fval reflect.Value) bool {
+	return ftyp.Type != fval.Type()
+}
+
+// setFromString parses a string according to the kind of a settable value
+// and assigns the result to it. Pointers are replaced with new ones, so the
+// values they pointed to are never modified.
+func setFromString(val reflect.Value, str string) error {
+	if ok, err := setTimeFromString(val, str); ok {
+		return err
+	}
+	if ok, err := setText(val, str); ok {
+		return err
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr:
+		ptr := reflect.New(val.Type().Elem())
+		if err := setFromString(ptr.Elem(), str); err != nil {
+			return err
+		}
+		val.Set(ptr)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return err
+		}
+		val.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(str, 10, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+		val.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(str, 10, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+		val.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(str, val.Type().Bits())
+		if err != nil {
+			return err
+		}
+		val.SetFloat(f)
+	case reflect.String:
+		val.SetString(str)
+	default:
+		return fmt.Errorf("Can't set value of kind %q", val.Kind())
+	}
+
+	return nil
+}
+
+// setFields parses values indexed by field path and assigns them to the
+// fields of a struct.
+func setFields(st interface{}, tagName string, values map[string]string) error {
+	var errs ValidationError
+	walkFields(reflect.ValueOf(st), tagName, "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		if str, ok := values[path]; ok {
+			if err := setFromString(fval, str); err != nil {
+				msg := "has invalid value " + strconv.Quote(str)
+				if isSecret(ftyp) {
+					msg = "has invalid value"
+				}
+				errs = append(errs, FieldError{Path: path, Msg: msg})
+			}
+		}
+	})
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
 }
 
-func diff(a, b interface{}) map[string][]interface{} {
-	af := indexFields(extractFields(a, ""))
-	bf := indexFields(extractFields(b, ""))
+// resizeContainers makes slices and maps of a struct match the shape: slices
+// get the length and maps get the keys listed in the shape for their path.
+// Existing elements are kept, new ones are zero values. Optional fields are
+// set to nil if they have nil keys in the shape and allocated otherwise.
+func resizeContainers(st interface{}, tagName string, shape map[string][]string) {
+	resize := func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		keys, ok := shape[path]
+		if !ok {
+			return
+		}
 
+		switch fval.Kind() {
+		case reflect.Ptr:
+			if keys == nil {
+				fval.Set(reflect.Zero(fval.Type()))
+			} else if fval.IsNil() {
+				fval.Set(reflect.New(fval.Type().Elem()))
+			}
+		case reflect.Slice:
+			res := reflect.MakeSlice(fval.Type(), len(keys), len(keys))
+			reflect.Copy(res, fval)
+			fval.Set(res)
+		case reflect.Map:
+			res := reflect.MakeMapWithSize(fval.Type(), len(keys))
+			for _, key := range keys {
+				kval := reflect.ValueOf(key).Convert(fval.Type().Key())
+				elem := fval.MapIndex(kval)
+				if !elem.IsValid() {
+					elem = reflect.Zero(fval.Type().Elem())
+				}
+				res.SetMapIndex(kval, elem)
+			}
+			fval.Set(res)
+		}
+	}
+	walkTree(reflect.ValueOf(st), tagName, "", resize, resize)
+}
+
+// setField assigns a value to the field at the given path.
+func setField(st interface{}, tagName, path string, value interface{}) {
+	walkFields(reflect.ValueOf(st), tagName, "", func(fpath string, ftyp reflect.StructField, fval reflect.Value) {
+		if fpath == path {
+			fval.Set(deepCopy(reflect.ValueOf(value)))
+		}
+	})
+}
+
+func diff(a, b interface{}, tagName string) map[string][]interface{} {
+	af := indexFields(extractFields(a, tagName, ""))
+	bf := indexFields(extractFields(b, tagName, ""))
+
+	// Fields of slice and map elements may be present in one of the configs
+	// only, the missing value is nil then. Values of text types may be
+	// slices, like net.IP, so they are not compared with ==.
 	res := make(map[string][]interface{})
 	for name, f := range af {
-		if bf[name].Value != f.Value {
+		if !reflect.DeepEqual(bf[name].Value, f.Value) {
 			res[name] = []interface{}{f.Value, bf[name].Value}
 		}
 	}
+	for name, f := range bf {
+		if _, ok := af[name]; !ok {
+			res[name] = []interface{}{nil, f.Value}
+		}
+	}
 
 	return res
 }
@@ -80,3 +513,17 @@ func indexFields(fields []field) map[string]field {
 
 	return res
 }
+
+// elementName returns the last token of an element path, e.g. [2] for
+// servers[2], region for labels.region or a.b for labels["a.b"].
+func elementName(path string) string {
+	tokens := splitPath(path)
+	if len(tokens) == 0 {
+		return path
+	}
+	if tok := tokens[len(tokens)-1]; !isIndexToken(tok) {
+		return tokenKey(tok)
+	}
+
+	return tokens[len(tokens)-1]
+}
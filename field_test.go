@@ -1,6 +1,11 @@
 package secondly
 
 import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -15,7 +20,7 @@ func TestExtractFields(t *testing.T) {
 		},
 	}
 
-	fields := indexFields(extractFields(c, ""))
+	fields := indexFields(extractFields(c, "json", ""))
 	testField := func(fname, kind string, val interface{}) {
 		if f, ok := fields[fname]; ok {
 			if f.Kind != kind {
@@ -57,14 +62,14 @@ func TestDiff(t *testing.T) {
 		},
 	}
 
-	d := diff(c1, c2)
+	d := diff(c1, c2, "json")
 	testField := func(fname string, oldVal, newVal interface{}) {
 		if f, ok := d[fname]; ok {
 			if f[0] != oldVal {
-				t.Errorf("%s field old value was %q, not %q", oldVal, f[0])
+				t.Errorf("%s field old value was %v, not %v", fname, f[0], oldVal)
 			}
 			if f[1] != newVal {
-				t.Errorf("%s field new value was %q, not %q", newVal, f[1])
+				t.Errorf("%s field new value was %v, not %v", fname, f[1], newVal)
 			}
 		} else {
 			t.Errorf("Expected %s field to have different values", fname)
@@ -83,3 +88,204 @@ func TestDiff(t *testing.T) {
 	testField("database.port", c1.Database.Port, c2.Database.Port)
 	testField("database.username", c1.Database.Username, c2.Database.Username)
 }
+
+type containerConf struct {
+	Servers []containerServerConf `json:"servers"`
+	Tags    []string              `json:"tags"`
+	Labels  map[string]string     `json:"labels"`
+}
+
+type containerServerConf struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+func TestContainerFields(t *testing.T) {
+	c := containerConf{
+		Servers: []containerServerConf{{"a", 1}, {"b", 2}},
+		Tags:    []string{"x"},
+		Labels:  map[string]string{"region": "eu"},
+	}
+
+	fields := indexFields(extractFields(c, "json", ""))
+	exp := map[string]interface{}{
+		"servers[0].host": "a",
+		"servers[1].port": 2,
+		"tags[0]":         "x",
+		"labels.region":   "eu",
+	}
+	for path, val := range exp {
+		if f, ok := fields[path]; !ok {
+			t.Errorf("Missing %s field", path)
+		} else if f.Value != val {
+			t.Errorf("%s expected to have value %v, got %v", path, val, f.Value)
+		}
+	}
+
+	c2 := duplicate(&c).(*containerConf)
+	c2.Servers[1].Port = 3
+	c2.Tags = append(c2.Tags, "y")
+	c2.Labels["region"] = "us"
+	if c.Servers[1].Port != 2 || c.Labels["region"] != "eu" {
+		t.Errorf("Copy shares data with the original: %+v", c)
+	}
+
+	d := diff(c, *c2, "json")
+	if len(d) != 3 {
+		t.Errorf("Expected 3 changes, got %d: %v", len(d), d)
+	}
+	if v, ok := d["tags[1]"]; !ok || v[0] != nil || v[1] != "y" {
+		t.Errorf("Expected tags[1] to be added, got %v", v)
+	}
+	if v := d["labels.region"]; v[0] != "eu" || v[1] != "us" {
+		t.Errorf("Expected labels.region to change, got %v", v)
+	}
+}
+
+func TestResizeContainers(t *testing.T) {
+	c := containerConf{
+		Servers: []containerServerConf{{"a", 1}, {"b", 2}},
+		Labels:  map[string]string{"region": "eu", "zone": "a"},
+	}
+
+	resizeContainers(&c, "json", map[string][]string{
+		"servers": {"0"},
+		"tags":    {"0", "1"},
+		"labels":  {"zone", "rack"},
+	})
+	if len(c.Servers) != 1 || c.Servers[0].Host != "a" {
+		t.Errorf("Expected servers to be truncated, got %+v", c.Servers)
+	}
+	if len(c.Tags) != 2 {
+		t.Errorf("Expected tags to be extended, got %+v", c.Tags)
+	}
+	if _, ok := c.Labels["region"]; ok || len(c.Labels) != 2 || c.Labels["zone"] != "a" {
+		t.Errorf("Unexpected labels: %+v", c.Labels)
+	}
+}
+
+type optionalConf struct {
+	Timeout  *int              `json:"timeout"`
+	Database *testDatabaseConf `json:"database"`
+}
+
+func TestOptionalFields(t *testing.T) {
+	var c1 optionalConf
+	fields := indexFields(extractFields(c1, "json", ""))
+	if f, ok := fields["timeout"]; !ok || !f.Unset || f.Value != nil || f.Kind != "int" {
+		t.Errorf("Expected timeout to be an unset int field, got %+v", f)
+	}
+	if _, ok := fields["database.port"]; ok {
+		t.Error("Expected fields of an unset section to be skipped")
+	}
+
+	c2 := duplicate(&c1).(*optionalConf)
+	setFields(c2, "json", map[string]string{"timeout": "5"})
+	c2.Database = &testDatabaseConf{Port: 3306}
+	d := diff(c1, *c2, "json")
+	if v := d["timeout"]; len(v) != 2 || v[0] != nil || v[1] != 5 {
+		t.Errorf("Expected timeout to change from nil to 5, got %v", v)
+	}
+	if v := d["database.port"]; len(v) != 2 || v[0] != nil || v[1] != 3306 {
+		t.Errorf("Expected database.port to change from nil to 3306, got %v", v)
+	}
+
+	resizeContainers(c2, "json", map[string][]string{"timeout": nil, "database": nil})
+	if c2.Timeout != nil || c2.Database != nil {
+		t.Errorf("Expected optional fields to be cleared, got %+v", c2)
+	}
+	if d := diff(c1, *c2, "json"); len(d) != 0 {
+		t.Errorf("Expected no changes, got %v", d)
+	}
+}
+
+type taggedBase struct {
+	ID string `json:"id" yaml:"id"`
+}
+
+type taggedConf struct {
+	taggedBase `yaml:",inline"`
+	*testDatabaseConf
+	Name     string `json:"name,omitempty" yaml:"name,omitempty"`
+	Secret   string `json:"-" yaml:"-"`
+	Untagged int
+	hidden   int
+}
+
+func TestFieldKeys(t *testing.T) {
+	c := taggedConf{
+		taggedBase:       taggedBase{ID: "a"},
+		testDatabaseConf: &testDatabaseConf{Port: 3306},
+		Name:             "b",
+		Untagged:         1,
+		hidden:           2,
+	}
+
+	fields := indexFields(extractFields(c, "json", ""))
+	for _, path := range []string{"id", "name", "Untagged", "port", "host"} {
+		if _, ok := fields[path]; !ok {
+			t.Errorf("Missing %s field", path)
+		}
+	}
+	for path := range fields {
+		switch path {
+		case "Secret", "-", "hidden", "name,omitempty", "", "taggedBase.id":
+			t.Errorf("Unexpected field %q", path)
+		}
+	}
+
+	fields = indexFields(extractFields(c, "yaml", ""))
+	for _, path := range []string{"id", "name", "untagged"} {
+		if _, ok := fields[path]; !ok {
+			t.Errorf("Missing %s field for YAML", path)
+		}
+	}
+
+	// TOML decoder doesn't read json tags
+	fields = indexFields(extractFields(c, "toml", ""))
+	for _, path := range []string{"ID", "Name", "Untagged"} {
+		if _, ok := fields[path]; !ok {
+			t.Errorf("Missing %s field for TOML", path)
+		}
+	}
+
+	c.testDatabaseConf = nil
+	if _, ok := indexFields(extractFields(c, "json", ""))["port"]; ok {
+		t.Error("Expected fields of a nil embedded struct to be skipped")
+	}
+}
+
+type unsupportedConf struct {
+	Name    string         `json:"name"`
+	Events  chan string    `json:"events"`
+	Weights map[int]string `json:"weights"`
+	Servers []struct {
+		Ports *[]int `json:"ports"`
+	} `json:"servers"`
+}
+
+func TestUnsupportedFields(t *testing.T) {
+	f := tempConfig(t, `{"name": "Secondly"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf unsupportedConf
+	var logs bytes.Buffer
+	m := testManager(f)
+	Logger(log.New(&logs, "", 0))(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	formFields(m.Snapshot(), "json")
+
+	// Unsupported types are reported once by the manager
+	exp := `Field type "chan" not supported for field "Events"
+Map key type "int" not supported for field "Weights"
+Field type "*slice" not supported for field "Servers.Ports"
+`
+	if out := logs.String(); !strings.HasPrefix(out, exp) {
+		t.Errorf("Expected unsupported fields to be reported, got %s", out)
+	}
+	if strings.Count(logs.String(), "not supported") != 3 {
+		t.Errorf("Expected each field to be reported once, got %s", logs.String())
+	}
+}
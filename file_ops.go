@@ -2,18 +2,30 @@ package secondly
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
+	"time"
 )
 
 const (
 	dirPerm  = 0755
-	filePerm = 0633
+	filePerm = 0644 // filePerm is used for new files, existing files keep their mode
 )
 
+// backupTimeFormat is a part of backup file names. It sorts in chronological
+// order.
+const backupTimeFormat = "20060102T150405.000000000"
+
 var errFileNotExist = errors.New("Config file does not exist")
 
+// linkFile makes hard links for backups. It is replaced in tests to force
+// backups to be copied.
+var linkFile = os.Link
+
 func readFile(file string) ([]byte, error) {
 	if ok := fileExist(file); ok {
 		return ioutil.ReadFile(file)
@@ -22,27 +34,125 @@ func readFile(file string) ([]byte, error) {
 	return nil, errFileNotExist
 }
 
-func writeFile(file string, body []byte) error {
-	var err error
-	if ok := fileExist(file); !ok {
+// writeFile replaces the contents of a file atomically: the body is written to
+// a temporary file in the same directory, which is then renamed over the
+// original, so readers never see a partially written file. Mode and ownership
+// of the existing file are preserved. If backups is positive, the previous
+// version is kept as a timestamped backup and only the given number of the
+// most recent backups are kept.
+func writeFile(file string, body []byte, backups int) error {
+	// Symlinks are kept, the file they point to is replaced
+	if target, err := filepath.EvalSymlinks(file); err == nil {
+		file = target
+	}
+
+	info, err := os.Stat(file)
+	exists := err == nil
+	if !exists {
 		if err = mkdirp(file); err != nil {
 			return err
 		}
 	}
 
-	var fd *os.File
-	if fd, err = os.OpenFile(file, os.O_TRUNC|os.O_WRONLY|os.O_CREATE, filePerm); err != nil {
+	tmp, err := ioutil.TempFile(path.Dir(file), "."+path.Base(file)+".tmp")
+	if err != nil {
 		return err
 	}
-	defer fd.Close()
+	// Removing the temporary file fails once it's renamed, which is fine
+	defer os.Remove(tmp.Name())
 
-	if _, err := fd.Write(body); err != nil {
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
 		return err
 	}
 
+	mode := os.FileMode(filePerm)
+	if exists {
+		mode = info.Mode().Perm()
+		if err := chown(tmp.Name(), info); err != nil {
+			return err
+		}
+	}
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+
+	if exists && backups > 0 {
+		if err := backupFile(file, info, backups); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return err
+	}
+	syncDir(path.Dir(file))
+
 	return nil
 }
 
+// backupFile keeps a copy of the current version of a file and removes old
+// copies, so that at most keep of them are left. Copies get the mode and
+// ownership of the file.
+func backupFile(file string, info os.FileInfo, keep int) error {
+	backup := fmt.Sprintf("%s.%s.bak", file, time.Now().UTC().Format(backupTimeFormat))
+	// A hard link is cheap and the file is replaced by rename anyway, so the
+	// link keeps the old contents
+	if err := linkFile(file, backup); err != nil {
+		if err := copyFile(file, backup, info); err != nil {
+			return err
+		}
+	}
+
+	old, err := filepath.Glob(file + ".*.bak")
+	if err != nil {
+		return err
+	}
+	sort.Strings(old)
+	for len(old) > keep {
+		if err := os.Remove(old[0]); err != nil {
+			return err
+		}
+		old = old[1:]
+	}
+
+	return nil
+}
+
+// copyFile copies a file where hard links are not supported. The copy is
+// created with the mode of the original, so it is never readable by more
+// users than the original.
+func copyFile(file, dst string, info os.FileInfo) error {
+	body, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(dst, body, info.Mode().Perm()); err != nil {
+		return err
+	}
+	if err := chown(dst, info); err != nil {
+		return err
+	}
+
+	// Mode of new files is limited by umask
+	return os.Chmod(dst, info.Mode().Perm())
+}
+
+// syncDir flushes directory entries so that the rename survives a crash. Not
+// every platform supports it, so errors are ignored.
+func syncDir(dir string) {
+	if fd, err := os.Open(dir); err == nil {
+		fd.Sync()
+		fd.Close()
+	}
+}
+
 func fileExist(file string) bool {
 	_, err := os.Stat(file)
 	return (err == nil)
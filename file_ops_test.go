@@ -0,0 +1,124 @@
+package secondly
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "conf", "config.json")
+	if err := writeFile(file, []byte("1"), 2); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(file); err != nil || info.Mode().Perm() != filePerm {
+		t.Fatalf("Expected new file to be created with default mode, got %v, %v", info, err)
+	}
+
+	if err := os.Chmod(file, 0600); err != nil {
+		t.Fatal(err)
+	}
+	for _, body := range []string{"2", "3", "4"} {
+		if err := writeFile(file, []byte(body), 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	body, err := ioutil.ReadFile(file)
+	if err != nil || string(body) != "4" {
+		t.Errorf("Expected file to contain the last write, got %q, %v", body, err)
+	}
+	if info, err := os.Stat(file); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("Expected file mode to be preserved, got %v, %v", info, err)
+	}
+
+	backups, err := filepath.Glob(file + ".*.bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("Expected 2 backups, got %v", backups)
+	}
+	if body, _ := ioutil.ReadFile(backups[1]); string(body) != "3" {
+		t.Errorf("Expected the latest backup to contain the previous version, got %q", body)
+	}
+
+	// Nothing but the file and the backups is left behind
+	entries, err := ioutil.ReadDir(filepath.Dir(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Errorf("Expected no temporary files to be left, got %d entries", len(entries))
+	}
+}
+
+func TestWriteFileSymlink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	target := filepath.Join(dir, "real.json")
+	link := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(target, []byte("1"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(target, link); err != nil {
+		t.Skip("Symlinks are not supported:", err)
+	}
+
+	if err := writeFile(link, []byte("2"), 0); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Lstat(link); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("Expected symlink to be kept, got %v, %v", info, err)
+	}
+	if body, _ := ioutil.ReadFile(target); string(body) != "2" {
+		t.Errorf("Expected symlink target to be updated, got %q", body)
+	}
+}
+
+func TestBackupCopy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Hard links are not supported everywhere
+	linkFile = func(string, string) error { return errors.New("Links are not supported") }
+	defer func() { linkFile = os.Link }()
+
+	file := filepath.Join(dir, "credentials.json")
+	if err := ioutil.WriteFile(file, []byte("1"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeFile(file, []byte("2"), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := filepath.Glob(file + ".*.bak")
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("Expected a backup, got %v, %v", backups, err)
+	}
+	info, err := os.Stat(backups[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected backup to keep the file mode, got %v", info.Mode())
+	}
+	if body, _ := ioutil.ReadFile(backups[0]); string(body) != "1" {
+		t.Errorf("Expected backup to contain the previous version, got %q", body)
+	}
+}
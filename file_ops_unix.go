@@ -0,0 +1,23 @@
+//go:build !windows
+// +build !windows
+
+package secondly
+
+import (
+	"os"
+	"syscall"
+)
+
+// chown gives a file the owner and the group of the original file. Only
+// privileged users may give files away, so permission errors are ignored.
+func chown(file string, orig os.FileInfo) error {
+	st, ok := orig.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	if err := os.Chown(file, int(st.Uid), int(st.Gid)); err != nil && !os.IsPermission(err) {
+		return err
+	}
+
+	return nil
+}
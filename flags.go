@@ -0,0 +1,116 @@
+package secondly
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// fieldFlag is a command-line flag that overrides a config field.
+type fieldFlag struct {
+	typ   reflect.Type
+	tag   string // tag is the struct tag the flag is named after
+	value string
+	set   bool
+}
+
+func (f *fieldFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return f.value
+}
+
+// Set makes sure the value can be assigned to the field, so that invalid values
+// are reported while parsing flags.
+func (f *fieldFlag) Set(str string) error {
+	if err := setFromString(reflect.New(f.typ).Elem(), str); err != nil {
+		return err
+	}
+	f.value, f.set = str, true
+
+	return nil
+}
+
+// IsBoolFlag allows boolean flags to be set without a value, e.g. -debug.
+func (f *fieldFlag) IsBoolFlag() bool {
+	return derefType(f.typ).Kind() == reflect.Bool
+}
+
+// SetupFieldFlags registers a command-line flag for every field of the config
+// struct, named after the field path, e.g. -database.port. Values of the flags
+// that were set take precedence over the config file and environment
+// variables and are re-applied on every reload.
+//
+// Config files are usually not known until flags are parsed, so flags are
+// named after the field paths of the format set with the Format option, or of
+// the format of the default config file, falling back to JSON. Flags that were
+// set are rejected by Manage if the config is loaded with another format.
+func (m *Manager) SetupFieldFlags(fs *flag.FlagSet, target interface{}) error {
+	if ok := isStructPtr(target); !ok {
+		return errNotStructPtr
+	}
+	var file string
+	if len(m.files) > 0 {
+		file = m.files[0]
+	}
+	cdc, err := findCodec(m.format, file)
+	if err != nil {
+		return err
+	}
+
+	tag := cdc.Tag()
+	flags := make(map[string]*fieldFlag)
+	walkFields(reflect.ValueOf(target), tag, "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		if fs.Lookup(path) != nil {
+			m.logger.Printf("Flag -%s is already defined, skipping\n", path)
+			return
+		}
+
+		f := &fieldFlag{typ: fval.Type(), tag: tag}
+		if val := leafValue(fval); val != nil {
+			f.value = fmt.Sprint(formatValue(val))
+		}
+		fs.Var(f, path, "Override "+path+" config value")
+		flags[path] = f
+	})
+
+	m.mu.Lock()
+	m.flags = flags
+	m.mu.Unlock()
+
+	return nil
+}
+
+// applyFlags overrides config values with command-line flags that were set.
+func (m *Manager) applyFlags(conf interface{}, pinned map[string]override) error {
+	m.mu.RLock()
+	flags := m.flags
+	m.mu.RUnlock()
+	if len(flags) == 0 {
+		return nil
+	}
+
+	tag := m.codec().Tag()
+	var unknown []string
+	for name, f := range flags {
+		if f.set && f.tag != tag {
+			unknown = append(unknown, "-"+name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("Flags don't match any field of the config: %s", strings.Join(unknown, ", "))
+	}
+
+	return overrideFields(conf, tag, pinned, func(path string, ftyp reflect.StructField, elem bool) (string, string, bool) {
+		if f, ok := flags[path]; ok && f.set {
+			return f.value, "-" + path + " flag", true
+		}
+
+		return "", "", false
+	})
+}
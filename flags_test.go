@@ -0,0 +1,161 @@
+package secondly
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type flagConf struct {
+	AppName  string           `json:"app_name"`
+	Debug    bool             `json:"debug"`
+	Database testDatabaseConf `json:"database"`
+}
+
+func TestFieldFlags(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "Secondly", "database": {"host": "localhost", "port": 3306}}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf flagConf
+	m := testManager(f)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	if err := m.SetupFieldFlags(fs, &conf); err != nil {
+		t.Fatal(err)
+	}
+	if fs.Lookup("database.port") == nil {
+		t.Fatal("Expected -database.port flag to be registered")
+	}
+
+	if err := fs.Parse([]string{"-database.port", "many"}); err == nil {
+		t.Error("Expected an error for invalid flag value")
+	}
+	if err := fs.Parse([]string{"-debug", "-database.port=5432"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if !conf.Debug || conf.Database.Port != 5432 {
+		t.Errorf("Expected flags to override config values, got %+v", conf)
+	}
+
+	if err := m.updateConfig([]byte(`{"database": {"host": "example.com", "port": 3307}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Database.Port != 5432 || conf.Database.Host != "example.com" {
+		t.Errorf("Expected flags to survive reload, got %+v", conf.Database)
+	}
+
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `"port": 3307`) || strings.Contains(string(body), `"debug": true`) {
+		t.Errorf("Flag values leaked into config file:\n%s", body)
+	}
+}
+
+func TestFlagsOverrideEnv(t *testing.T) {
+	os.Setenv("TEST_DATABASE_PORT", "1234")
+	defer os.Unsetenv("TEST_DATABASE_PORT")
+
+	f := tempConfig(t, `{"database": {"port": 3306}}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	Env("test")(m)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	if err := m.SetupFieldFlags(fs, &conf); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.Parse([]string{"-database.port=5432"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	if conf.Database.Port != 5432 {
+		t.Errorf("Expected flag to take precedence over env, got %d", conf.Database.Port)
+	}
+	if o := m.pinnedFields()["database.port"]; o.orig != 3306 || o.source != "-database.port flag" {
+		t.Errorf("Unexpected override: %+v", o)
+	}
+}
+
+type yamlFlagConf struct {
+	AppName string `json:"app_name" yaml:"name"`
+	Port    int    `json:"port"`
+}
+
+func TestFieldFlagsFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(f, []byte("name: Secondly\nport: 3306\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	setup := func(file string, args ...string) (*Manager, *yamlFlagConf, *flag.FlagSet) {
+		var conf yamlFlagConf
+		m := testManager(file)
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(ioutil.Discard)
+		if err := m.SetupFieldFlags(fs, &conf); err != nil {
+			t.Fatal(err)
+		}
+		if err := fs.Parse(args); err != nil {
+			t.Fatal(err)
+		}
+		return m, &conf, fs
+	}
+
+	// Flags are named after the format of the default config file
+	m, conf, fs := setup(f, "-name=Flagged", "-port=5432")
+	if fs.Lookup("app_name") != nil || fs.Lookup("Port") != nil {
+		t.Error("Expected flags to be registered for YAML paths only")
+	}
+	if err := m.Manage(conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.AppName != "Flagged" || conf.Port != 5432 {
+		t.Errorf("Expected flags to override config values, got %+v", conf)
+	}
+
+	// Without a default file flags are named after JSON paths
+	m, conf, _ = setup("", "-app_name=Flagged")
+	if err := m.Manage(conf, f); err == nil || !strings.Contains(err.Error(), "-app_name") {
+		t.Errorf("Expected an error for a flag of another format, got %v", err)
+	}
+}
+
+func TestFieldFlagsOwnFlags(t *testing.T) {
+	var conf flagConf
+	m := testManager("")
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	if err := m.SetupFieldFlags(fs, &conf); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the paths of one format are claimed
+	for _, name := range []string{"AppName", "Debug", "Database.Port"} {
+		if fs.Lookup(name) != nil {
+			t.Errorf("Expected -%s flag not to be registered", name)
+		}
+	}
+	if fs.Lookup("app_name") == nil {
+		t.Error("Expected -app_name flag to be registered")
+	}
+}
@@ -0,0 +1,44 @@
+package secondly
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Reloads are content-addressed: the config is only decoded again if the
+// contents of config files differ from the ones it was last loaded from or
+// saved to. That makes repeated SIGHUPs and file system events cheap, and the
+// event caused by saving the config from the web GUI doesn't reload it again.
+
+// Hash returns a hex encoded SHA-256 hash of the config files the current
+// config was loaded from or saved to. It is empty until the config is loaded.
+func (m *Manager) Hash() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.hash
+}
+
+func (m *Manager) setHash(hash string) {
+	m.mu.Lock()
+	m.hash = hash
+	m.mu.Unlock()
+}
+
+// hashLayers returns a hex encoded SHA-256 hash of the contents of config
+// files.
+func hashLayers(bodies [][]byte) string {
+	h := sha256.New()
+	for _, body := range bodies {
+		// Length prefix tells a missing file from an empty one
+		if body == nil {
+			fmt.Fprint(h, "-1:")
+		} else {
+			fmt.Fprintf(h, "%d:", len(body))
+		}
+		h.Write(body)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,136 @@
+package secondly
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHashLayers(t *testing.T) {
+	if hashLayers([][]byte{nil}) == hashLayers([][]byte{{}}) {
+		t.Error("Expected a missing file and an empty one to have different hashes")
+	}
+	if hashLayers([][]byte{[]byte("ab"), []byte("c")}) == hashLayers([][]byte{[]byte("a"), []byte("bc")}) {
+		t.Error("Expected file boundaries to affect the hash")
+	}
+}
+
+func TestSkipUnchanged(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "one"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	var logs syncBuffer
+	m := testManager(f)
+	Logger(log.New(&logs, "", 0))(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	hash := m.Hash()
+	if hash == "" {
+		t.Fatal("Expected hash to be set after load")
+	}
+
+	var changes int
+	m.OnChange("app_name", func(_, _ interface{}) { changes++ })
+	if err := m.readConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logs.String(), "unchanged") {
+		t.Errorf("Expected reload to be skipped, got logs: %s", logs.String())
+	}
+
+	if err := ioutil.WriteFile(f, []byte(`{"app_name": "two"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.readConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if conf.AppName != "two" || changes != 1 {
+		t.Errorf("Expected config to be reloaded once, got %q after %d changes", conf.AppName, changes)
+	}
+	if m.Hash() == hash {
+		t.Error("Expected hash to change")
+	}
+}
+
+func TestSaveHash(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "one"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	var logs syncBuffer
+	m := testManager(f)
+	Logger(log.New(&logs, "", 0))(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	rw := httptest.NewRecorder()
+	m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(`{"app_name": "two"}`)))
+	if rw.Code != 200 {
+		t.Fatalf("Expected save to succeed, got %d: %s", rw.Code, rw.Body)
+	}
+	body, err := ioutil.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := hashLayers([][]byte{body}); m.Hash() != exp {
+		t.Errorf("Expected hash of the saved file %s, got %s", exp, m.Hash())
+	}
+
+	// Reload caused by the save is skipped
+	if err := m.readConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logs.String(), "unchanged") {
+		t.Errorf("Expected reload to be skipped, got logs: %s", logs.String())
+	}
+
+	rw = httptest.NewRecorder()
+	m.hashHandler(rw, httptest.NewRequest("GET", "/hash.json", nil))
+	var resp struct{ Hash string }
+	if err := json.Unmarshal(rw.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Hash != m.Hash() {
+		t.Errorf("Expected %s, got %s", m.Hash(), resp.Hash)
+	}
+}
+
+func TestSaveDuringReload(t *testing.T) {
+	f := tempConfig(t, `{"version": 0}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reloads keep running while the config is saved
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			m.readConfig()
+		}
+	}()
+	for i := 1; i <= 50; i++ {
+		rw := httptest.NewRecorder()
+		m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(`{"version": "`+fmt.Sprint(i)+`"}`)))
+		if rw.Code != 200 {
+			t.Fatalf("Expected save to succeed, got %d: %s", rw.Code, rw.Body.String())
+		}
+		if v := m.Snapshot().(*testConf).Version; int(v) != i {
+			t.Fatalf("Expected the saved version %d to stay in memory, got %v", i, v)
+		}
+	}
+	<-done
+}
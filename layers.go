@@ -0,0 +1,299 @@
+package secondly
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// layer is a single config file. Layers are merged in order, so values from
+// later layers override values from earlier ones.
+type layer struct {
+	file string
+	cdc  Codec
+}
+
+// fileList is a flag value that holds a comma-separated list of config files.
+type fileList []string
+
+func (l *fileList) String() string {
+	if l == nil {
+		return ""
+	}
+
+	return strings.Join(*l, ",")
+}
+
+func (l *fileList) Set(str string) error {
+	*l = strings.Split(str, ",")
+	return nil
+}
+
+// resolveLayers finds a codec for every config file and makes sure the
+// writable layer is one of them.
+func (m *Manager) resolveLayers() ([]layer, error) {
+	if len(m.files) == 0 {
+		return nil, errNoConfigFile
+	}
+
+	layers := make([]layer, len(m.files))
+	for i, file := range m.files {
+		if file == "" {
+			return nil, errNoConfigFile
+		}
+		cdc, err := findCodec(m.format, file)
+		if err != nil {
+			return nil, err
+		}
+		layers[i] = layer{file: file, cdc: cdc}
+	}
+
+	if m.writable != "" && m.writableLayer(layers) < 0 {
+		return nil, fmt.Errorf("Writable config file %q is not one of the config files", m.writable)
+	}
+
+	return layers, nil
+}
+
+// writableLayer returns the index of the layer the web GUI saves changes to.
+// It defaults to the last layer.
+func (m *Manager) writableLayer(layers []layer) int {
+	if m.writable == "" {
+		return len(layers) - 1
+	}
+	for i, l := range layers {
+		if l.file == m.writable {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// readLayers reads the contents of all config files. The first file must
+// exist, missing files of other layers are skipped.
+func readLayers(layers []layer) ([][]byte, error) {
+	bodies := make([][]byte, len(layers))
+	for i, l := range layers {
+		if i > 0 && !fileExist(l.file) {
+			continue
+		}
+		body, err := readFile(l.file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", l.file, err)
+		}
+		bodies[i] = body
+	}
+
+	return bodies, nil
+}
+
+// decodeLayers decodes the contents of config files one on top of another.
+func decodeLayers(layers []layer, bodies [][]byte, target interface{}) error {
+	for i, l := range layers {
+		if bodies[i] == nil {
+			continue
+		}
+		if err := l.cdc.Decode(bodies[i], target); err != nil {
+			return fmt.Errorf("%s: %w", l.file, err)
+		}
+	}
+
+	return nil
+}
+
+// decodeTrees decodes the contents of config files into generic trees. Trees
+// of layers that are missing or whose codecs can't provide them are nil.
+func decodeTrees(layers []layer, bodies [][]byte) ([]map[string]interface{}, error) {
+	trees := make([]map[string]interface{}, len(layers))
+	for i, l := range layers {
+		tc, ok := l.cdc.(TreeCodec)
+		if bodies[i] == nil || !ok {
+			continue
+		}
+		tree, err := tc.DecodeTree(bodies[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", l.file, err)
+		}
+		if tree == nil {
+			tree = map[string]interface{}{}
+		}
+		trees[i] = tree
+	}
+
+	return trees, nil
+}
+
+// layerContents returns the values that have to be written to the layer with
+// the given index so that merging it on top of defaults and the layers below
+// results in the given config. The first layer contains the whole config,
+// other layers only contain a tree of values that differ from the layers
+// below. Override layers of codecs that can't encode trees contain the whole
+// config too. Values of the encrypted fields are encrypted.
+func (m *Manager) layerContents(conf interface{}, layers []layer, idx int, encrypted map[string]bool) (interface{}, error) {
+	if _, ok := layers[idx].cdc.(TreeCodec); idx == 0 || !ok {
+		return conf, m.encryptFields(conf, encrypted)
+	}
+
+	base := m.defaultConfig()
+	bodies, err := readLayers(layers[:idx])
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeLayers(layers[:idx], bodies, base); err != nil {
+		return nil, err
+	}
+	if err := m.applySectionDefaults(base, layers[:idx], bodies); err != nil {
+		return nil, err
+	}
+	// Layers below are compared in plaintext, as encrypting the same value
+	// twice gives different results
+	if _, err := m.decryptFields(base); err != nil {
+		return nil, err
+	}
+
+	tagName := m.codec().Tag()
+	baseContainers, confContainers := containerValues(base, tagName), containerValues(conf, tagName)
+	units := make(map[string]bool)
+	for path := range diff(base, conf, tagName) {
+		units[layerUnit(path, baseContainers, confContainers)] = true
+	}
+
+	// Values of every unit are written as a whole
+	values := make(map[string]interface{})
+	for _, f := range extractFields(conf, tagName, "") {
+		if unit, ok := unitOf(f.Path, units); ok {
+			values[f.Path] = f.Value
+			units[unit] = false
+		}
+	}
+	if err := m.encryptValues(values, encrypted); err != nil {
+		return nil, err
+	}
+
+	var tree interface{} = map[string]interface{}{}
+	for path, val := range values {
+		tree = setTreeValue(tree, splitPath(path), val, false)
+	}
+	for path, fval := range confContainers {
+		if _, ok := unitOf(path, units); !ok {
+			continue
+		}
+		switch {
+		case fval.Kind() == reflect.Slice && fval.Len() == 0:
+			tree = setTreeValue(tree, splitPath(path), []interface{}{}, true)
+		case fval.Kind() == reflect.Map && fval.Len() == 0:
+			tree = setTreeValue(tree, splitPath(path), map[string]interface{}{}, true)
+		case fval.Kind() == reflect.Ptr && fval.IsNil():
+			tree = setTreeValue(tree, splitPath(path), nil, true)
+		default:
+			continue
+		}
+		units[path] = false
+	}
+
+	// Units that have no values are elements removed from the config
+	for unit, missing := range units {
+		if missing {
+			return nil, fmt.Errorf("Can't remove %s, it is set in a config file other than %s", unit, layers[idx].file)
+		}
+	}
+
+	return tree, nil
+}
+
+// encodeLayer serializes the contents of a layer returned by layerContents.
+func encodeLayer(cdc Codec, obj interface{}) ([]byte, error) {
+	if tree, ok := obj.(map[string]interface{}); ok {
+		return cdc.(TreeCodec).EncodeTree(tree)
+	}
+
+	return cdc.Encode(obj)
+}
+
+// containerValues returns slices, maps and optional sections of a config
+// indexed by path.
+func containerValues(conf interface{}, tagName string) map[string]reflect.Value {
+	res := make(map[string]reflect.Value)
+	walkTree(reflect.ValueOf(conf), tagName, "", func(string, reflect.StructField, reflect.Value) {}, func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		res[path] = fval
+	})
+
+	return res
+}
+
+// layerUnit returns the path of a value that has to be written as a whole
+// when the field at the given path changes. Decoders replace slices as a
+// whole and map elements one by one, so a change of an element field
+// rewrites the whole slice or map element. Optional sections that are unset
+// on either side are written as a whole too. Other fields are written alone.
+func layerUnit(path string, base, conf map[string]reflect.Value) string {
+	tokens := splitPath(path)
+	for i := range tokens {
+		prefix := joinTokens(tokens[:i+1])
+		bval, inBase := base[prefix]
+		cval, inConf := conf[prefix]
+		val := cval
+		if !inConf {
+			val = bval
+		}
+
+		switch {
+		case !inBase && !inConf:
+			continue
+		case val.Kind() == reflect.Slice:
+			return prefix
+		case val.Kind() == reflect.Map && i+1 < len(tokens):
+			return joinTokens(tokens[:i+2])
+		case val.Kind() == reflect.Ptr && (!inBase || !inConf || bval.IsNil() || cval.IsNil()):
+			return prefix
+		}
+	}
+
+	return path
+}
+
+// unitOf returns the unit the path belongs to.
+func unitOf(path string, units map[string]bool) (string, bool) {
+	tokens := splitPath(path)
+	for i := range tokens {
+		prefix := joinTokens(tokens[:i+1])
+		if _, ok := units[prefix]; ok {
+			return prefix, true
+		}
+	}
+
+	return "", false
+}
+
+// setTreeValue sets a value in nested maps and slices at the path given by
+// tokens. Missing maps are created and slices are grown as needed. Existing
+// values are kept if keep is set.
+func setTreeValue(node interface{}, tokens []string, val interface{}, keep bool) interface{} {
+	if len(tokens) == 0 {
+		if keep && node != nil {
+			return node
+		}
+		return val
+	}
+
+	key := tokenKey(tokens[0])
+	if isIndexToken(tokens[0]) {
+		i, _ := strconv.Atoi(key)
+		s, _ := node.([]interface{})
+		for len(s) <= i {
+			s = append(s, nil)
+		}
+		s[i] = setTreeValue(s[i], tokens[1:], val, keep)
+		return s
+	}
+
+	obj, ok := node.(map[string]interface{})
+	if !ok {
+		obj = make(map[string]interface{})
+	}
+	obj[key] = setTreeValue(obj[key], tokens[1:], val, keep)
+
+	return obj
+}
@@ -0,0 +1,216 @@
+package secondly
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLayers(t *testing.T) {
+	base := tempConfig(t, `{"app_name": "Secondly", "version": 1, "database": {"host": "localhost", "port": 3306}}`)
+	dir := filepath.Dir(base)
+	defer os.RemoveAll(dir)
+	prod := filepath.Join(dir, "config.production.json")
+	if err := ioutil.WriteFile(prod, []byte(`{"database": {"host": "db.example.com"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	local := filepath.Join(dir, "config.local.json")
+
+	var conf testConf
+	m := testManager("")
+	Writable(local)(m)
+	if err := m.Manage(&conf, base, prod, local); err != nil {
+		t.Fatal(err)
+	}
+	if conf.AppName != "Secondly" || conf.Database.Host != "db.example.com" || conf.Database.Port != 3306 {
+		t.Errorf("Layers were not merged correctly: %+v", conf)
+	}
+
+	if err := m.update(func(dupe interface{}) error {
+		dupe.(*testConf).Database.Port = 5432
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := ioutil.ReadFile(local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var written map[string]interface{}
+	if err := json.Unmarshal(body, &written); err != nil {
+		t.Fatal(err)
+	}
+	exp := map[string]interface{}{"database": map[string]interface{}{"port": float64(5432)}}
+	if !reflect.DeepEqual(written, exp) {
+		t.Errorf("Expected writable layer to contain %v, got %v", exp, written)
+	}
+
+	// Base layer stays untouched
+	body, err = ioutil.ReadFile(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(body, &written); err != nil {
+		t.Fatal(err)
+	}
+	if written["database"].(map[string]interface{})["port"] != float64(3306) {
+		t.Errorf("Base layer was modified: %s", body)
+	}
+}
+
+func TestWritableLayerMustExist(t *testing.T) {
+	f := tempConfig(t, goodJSON)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	Writable("other.json")(m)
+	if err := m.Manage(&conf); err == nil {
+		t.Error("Expected an error for unknown writable layer")
+	}
+}
+
+func TestLayerContainers(t *testing.T) {
+	base := tempConfig(t, `{"servers": [{"host": "a", "port": 1}, {"host": "b", "port": 2}, {"host": "c", "port": 3}], "tags": ["x"], "labels": {"region": "eu"}}`)
+	dir := filepath.Dir(base)
+	defer os.RemoveAll(dir)
+	local := filepath.Join(dir, "config.local.json")
+
+	var conf containerConf
+	m := testManager("")
+	Strict()(m)
+	if err := m.Manage(&conf, base, local); err != nil {
+		t.Fatal(err)
+	}
+
+	save := func(body string) int {
+		rw := httptest.NewRecorder()
+		m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(body)))
+		return rw.Code
+	}
+	reload := func() containerConf {
+		m.setHash("")
+		if err := m.readConfig(); err != nil {
+			t.Fatal(err)
+		}
+		return *m.Snapshot().(*containerConf)
+	}
+	written := func() map[string]interface{} {
+		body, err := ioutil.ReadFile(local)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var res map[string]interface{}
+		if err := json.Unmarshal(body, &res); err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	// Changed and removed slice elements, added map elements
+	code := save(`{"servers": [{"host": "a", "port": 1}, {"host": "z", "port": 2}], "tags": ["x"], "labels": {"region": "eu", "app.kubernetes.io/name": "web", "zone": "a"}}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected save to succeed, got %d", code)
+	}
+	exp := map[string]interface{}{
+		"servers": []interface{}{
+			map[string]interface{}{"host": "a", "port": float64(1)},
+			map[string]interface{}{"host": "z", "port": float64(2)},
+		},
+		"labels": map[string]interface{}{"app.kubernetes.io/name": "web", "zone": "a"},
+	}
+	if res := written(); !reflect.DeepEqual(res, exp) {
+		t.Errorf("Expected writable layer to contain %v, got %v", exp, res)
+	}
+	expConf := containerConf{
+		Servers: []containerServerConf{{"a", 1}, {"z", 2}},
+		Tags:    []string{"x"},
+		Labels:  map[string]string{"region": "eu", "app.kubernetes.io/name": "web", "zone": "a"},
+	}
+	if res := reload(); !reflect.DeepEqual(res, expConf) {
+		t.Errorf("Expected %+v after reload, got %+v", expConf, res)
+	}
+
+	// Added slice elements, removed map elements of the writable layer
+	code = save(`{"servers": [{"host": "a", "port": 1}, {"host": "z", "port": 2}, {"host": "n", "port": 4}], "tags": ["x"], "labels": {"region": "eu", "app.kubernetes.io/name": "web"}}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected save to succeed, got %d", code)
+	}
+	expConf.Servers = append(expConf.Servers, containerServerConf{"n", 4})
+	delete(expConf.Labels, "zone")
+	if res := reload(); !reflect.DeepEqual(res, expConf) {
+		t.Errorf("Expected %+v after reload, got %+v", expConf, res)
+	}
+
+	// Map elements of the layers below can't be removed
+	code = save(`{"servers": [], "tags": ["x"], "labels": {"app.kubernetes.io/name": "web"}}`)
+	if code != http.StatusInternalServerError {
+		t.Errorf("Expected save to fail, got %d", code)
+	}
+	if res := reload(); !reflect.DeepEqual(res, expConf) {
+		t.Errorf("Expected %+v after reload, got %+v", expConf, res)
+	}
+}
+
+func TestSetTreeValue(t *testing.T) {
+	var tree interface{}
+	tree = setTreeValue(tree, splitPath("database.host"), "localhost", false)
+	tree = setTreeValue(tree, splitPath("servers[1].port"), 2, false)
+	tree = setTreeValue(tree, splitPath(`labels["a.b"]`), "x", false)
+	tree = setTreeValue(tree, splitPath("labels"), map[string]interface{}{}, true)
+	exp := map[string]interface{}{
+		"database": map[string]interface{}{"host": "localhost"},
+		"servers":  []interface{}{nil, map[string]interface{}{"port": 2}},
+		"labels":   map[string]interface{}{"a.b": "x"},
+	}
+	if !reflect.DeepEqual(tree, exp) {
+		t.Errorf("Expected %v, got %v", exp, tree)
+	}
+}
+
+func TestLayersCustomCodec(t *testing.T) {
+	registerKVCodec(t)
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	base, local := filepath.Join(dir, "config.kv"), filepath.Join(dir, "config.local.kv")
+	if err := ioutil.WriteFile(base, []byte("HOST=localhost\nPORT=5\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var conf kvConf
+	m := testManager("")
+	if err := m.Manage(&conf, base, local); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.update(func(dupe interface{}) error {
+		dupe.(*kvConf).Port = "6"
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Codec can't encode trees, so the whole config is written
+	body, err := ioutil.ReadFile(local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "HOST=localhost\nPORT=6\n"; string(body) != exp {
+		t.Errorf("Expected writable layer to contain %q, got %q", exp, body)
+	}
+}
@@ -0,0 +1,556 @@
+package secondly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
+)
+
+var (
+	errNotStructPtr = errors.New("Argument must be a pointer to a struct")
+	errNoConfigFile = errors.New("Path to config file is not set")
+)
+
+// Manager takes care of a single configuration struct. It owns the config
+// value, the paths to config files and all of the callbacks, so multiple
+// managers can coexist in one process.
+//
+// The struct passed to Manage is updated in place on every reload. Reading it
+// directly from other goroutines while a reload may happen is a data race; use
+// Snapshot to get a consistent copy instead.
+type Manager struct {
+	files       []string
+	writable    string
+	layers      []layer
+	format      string
+	cdc         Codec // cdc is the codec of the first layer, its tags define field paths
+	env         bool
+	envPrefix   string
+	pinned      map[string]override
+	flags       map[string]*fieldFlag
+	keys        KeyProvider
+	encrypted   map[string]bool // encrypted holds paths of fields that are encrypted in config files
+	unknownKeys unknownKeysMode
+	backups     int
+	poll        time.Duration // poll is the interval of polling used when file system events are not available
+	hash        string        // hash is the hash of config files the config was last loaded from or saved to
+	logger      *log.Logger
+	config      interface{} // config stores application config
+	defaults    interface{} // defaults is the config every reload starts with
+	callbacks   map[string][]func(oldVal, newVal interface{})
+	validators  []func(newConf interface{}) error
+	initialized bool
+	initFunc    func()
+	errorFunc   func(err error)
+	warnFunc    func(err error)
+	closed      bool
+	closers     []func(ctx context.Context) error // closers stop background goroutines
+
+	mu     sync.RWMutex   // mu guards config value, layers, overrides, callbacks and closers
+	reload sync.Mutex     // reload serializes config updates
+	wg     sync.WaitGroup // wg tracks background goroutines
+}
+
+// Option configures a Manager.
+type Option func(m *Manager)
+
+// File sets the path to the config file.
+func File(path string) Option {
+	return func(m *Manager) {
+		m.files = []string{path}
+	}
+}
+
+// Writable sets the config file that changes made in the web GUI are saved
+// to. It must be one of the config files and defaults to the last one.
+func Writable(path string) Option {
+	return func(m *Manager) {
+		m.writable = path
+	}
+}
+
+// Format sets the config file format: "json", "yaml", "toml" or the name of a
+// codec added with RegisterCodec. By default the format is guessed from the
+// file extension.
+func Format(name string) Option {
+	return func(m *Manager) {
+		m.format = name
+	}
+}
+
+// Env enables overriding config values with environment variables. A field
+// is looked up by the name in its env tag, or, if prefix is not empty, by a
+// name built from the prefix and the field path: APP_DATABASE_PORT for the
+// database.port field and APP prefix. Overrides are re-applied on every reload.
+func Env(prefix string) Option {
+	return func(m *Manager) {
+		m.env = true
+		m.envPrefix = prefix
+	}
+}
+
+// Strict makes the manager reject configs that contain unknown keys, e.g. a
+// misspelled section name.
+func Strict() Option {
+	return func(m *Manager) {
+		m.unknownKeys = rejectUnknownKeys
+	}
+}
+
+// Lenient makes the manager report unknown config keys as warnings without
+// rejecting the config.
+func Lenient() Option {
+	return func(m *Manager) {
+		m.unknownKeys = warnUnknownKeys
+	}
+}
+
+// Backups makes the manager keep the given number of previous versions of the
+// config file when the web GUI saves it. Backups are named after the file with
+// a timestamp and a .bak extension.
+func Backups(n int) Option {
+	return func(m *Manager) {
+		m.backups = n
+	}
+}
+
+// PollInterval sets how often config files are checked for changes when
+// HandleFileSystemEvents falls back to polling. It defaults to 5 seconds.
+func PollInterval(d time.Duration) Option {
+	return func(m *Manager) {
+		m.poll = d
+	}
+}
+
+// Logger sets the logger used to report reloads and errors.
+func Logger(l *log.Logger) Option {
+	return func(m *Manager) {
+		m.logger = l
+	}
+}
+
+// New creates a new configuration manager.
+func New(opts ...Option) *Manager {
+	m := &Manager{
+		files:     []string{"config.json"},
+		logger:    log.New(os.Stderr, "", log.LstdFlags),
+		callbacks: make(map[string][]func(oldVal, newVal interface{})),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Manage accepts a pointer to a configuration struct and loads the config file
+// into it. An error is returned if the initial load fails.
+//
+// If multiple files are given, they are loaded as layers: values from every
+// following file override values from the previous ones. Files replace the
+// ones set with options or flags.
+func (m *Manager) Manage(target interface{}, files ...string) error {
+	if ok := isStructPtr(target); !ok {
+		return errNotStructPtr
+	}
+	if err := checkPatterns(reflect.TypeOf(target), "", make(map[reflect.Type]bool)); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	if len(files) > 0 {
+		m.files = files
+	}
+	layers, err := m.resolveLayers()
+	m.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	m.warnUncheckedLayers(layers)
+
+	// Defaults are found by the tag of the first layer, as are field paths
+	defaults, err := makeDefaults(target, layers[0].cdc.Tag())
+	if err != nil {
+		return err
+	}
+	for _, msg := range unsupportedFields(reflect.TypeOf(target).Elem(), layers[0].cdc.Tag(), "", make(map[reflect.Type]bool)) {
+		m.logger.Println(msg)
+	}
+
+	m.mu.Lock()
+	m.config = target
+	m.defaults = defaults
+	m.layers = layers
+	m.cdc = layers[0].cdc
+	m.mu.Unlock()
+
+	return m.bootstrap()
+}
+
+// Snapshot returns a pointer to a copy of the current configuration. The copy
+// is never modified by the manager, so it is safe to read it concurrently with
+// reloads. It returns nil if Manage has not been called yet.
+func (m *Manager) Snapshot() interface{} {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.config == nil {
+		return nil
+	}
+
+	return duplicate(m.config)
+}
+
+// StartServer will start an HTTP server with web interface to edit config.
+func (m *Manager) StartServer(host string, port int) {
+	m.startServer(fmt.Sprintf("%s:%d", host, port))
+}
+
+// HandleSIGHUP waits a SIGHUP system call and reloads configuration when
+// receives one.
+func (m *Manager) HandleSIGHUP() {
+	ch := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(ch, syscall.SIGHUP)
+	run := func() {
+		for {
+			select {
+			case <-ch:
+				m.logger.Println("SIGHUP received, reloading config")
+				if err := m.readConfig(); err != nil {
+					m.reportError(err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}
+	stop := func(ctx context.Context) error {
+		signal.Stop(ch)
+		close(done)
+		return nil
+	}
+	if !m.background(run, stop) {
+		signal.Stop(ch)
+	}
+}
+
+// HandleFileSystemEvents listens to file system events and reloads configuration when
+// any of config files is modified, replaced or, if it is a symlink, pointed to
+// another file. Bursts of events are merged into a single reload. If file
+// system events are not available, config files are polled instead.
+func (m *Manager) HandleFileSystemEvents() {
+	w, err := newFileWatcher(m)
+	if err != nil {
+		interval := m.poll
+		if interval <= 0 {
+			interval = defaultPollInterval
+		}
+		m.reportWarning(fmt.Errorf("Failed to watch config files, polling them every %s instead: %w", interval, err))
+		m.PollFiles(interval)
+		return
+	}
+
+	if !m.background(w.run, w.stop) {
+		w.close()
+	}
+}
+
+// PollFiles checks config files for changes at the given interval and reloads
+// configuration when any of them is modified. Use it instead of
+// HandleFileSystemEvents on file systems that don't deliver events, like NFS.
+func (m *Manager) PollFiles(interval time.Duration) {
+	p := newFilePoller(m, interval)
+	m.background(p.run, p.stop)
+}
+
+// OnLoad sets up a callback function that would be called once configuration
+// is loaded for the first time.
+func (m *Manager) OnLoad(fun func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.initFunc = fun
+}
+
+// OnChange adds a callback function that is triggered every time a value of
+// a field changes. Field is the path of the field as it appears in the config
+// file, e.g. database.port.
+func (m *Manager) OnChange(field string, fun func(oldVal, newVal interface{})) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks[field] = append(m.callbacks[field], fun)
+}
+
+// Validate adds a function that checks a candidate config before it is
+// applied. The function receives a pointer to a copy of the config struct; if
+// it returns an error the reload is rejected and the old config stays in place.
+func (m *Manager) Validate(fun func(newConf interface{}) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.validators = append(m.validators, fun)
+}
+
+// OnError sets up a callback function that is called when a config reload
+// fails. The last good configuration is kept in place in that case.
+func (m *Manager) OnError(fun func(err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.errorFunc = fun
+}
+
+// OnWarning sets up a callback function that is called when a config is
+// loaded, but has problems, like unknown keys in lenient mode.
+func (m *Manager) OnWarning(fun func(err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.warnFunc = fun
+}
+
+// asign is responsible for assigning new config value. It is complicated
+// because we're changing the value of an interface which is defined in
+// another package.
+func (m *Manager) assign(target interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cval := reflect.ValueOf(m.config).Elem()
+	tval := reflect.ValueOf(target).Elem()
+	cval.Set(tval)
+}
+
+// bootstrap sets up initial configuration.
+func (m *Manager) bootstrap() error {
+	m.logger.Println("Loading config file")
+	return m.readConfig()
+}
+
+// readConfig reads all config files and merges them into the default config,
+// so that values removed from the files revert to their defaults. Files are
+// read and compared with the hash under the reload lock, so that a reload
+// can't run in the middle of a save.
+func (m *Manager) readConfig() error {
+	m.reload.Lock()
+	defer m.reload.Unlock()
+
+	layers := m.layerList()
+	bodies, err := readLayers(layers)
+	if err != nil {
+		return err
+	}
+	hash := hashLayers(bodies)
+	if hash == m.Hash() {
+		m.logger.Println("Config files are unchanged, skipping reload")
+		return nil
+	}
+	if err := m.checkLayerKeys(layers, bodies); err != nil {
+		return err
+	}
+
+	err = m.apply(func(dupe interface{}) (map[string]bool, error) {
+		m.resetToDefaults(dupe)
+		if err := decodeLayers(layers, bodies, dupe); err != nil {
+			return nil, err
+		}
+		if err := m.applySectionDefaults(dupe, layers, bodies); err != nil {
+			return nil, err
+		}
+		return m.decryptFields(dupe)
+	})
+	if err != nil {
+		return err
+	}
+	m.setHash(hash)
+
+	return nil
+}
+
+// writeConfig saves the config to the writable layer. Fields that were
+// encrypted are encrypted again. Saves hold the reload lock across the update
+// and the write, so that the hash always matches the config in memory.
+func (m *Manager) writeConfig() error {
+	// Config no longer matches the files until they are written, so that a
+	// reload after a failed write restores it
+	m.setHash("")
+
+	// Values from the environment and flags must not leak into the file
+	conf := m.Snapshot()
+	m.restorePinned(conf)
+
+	layers := m.layerList()
+	idx := m.writableLayer(layers)
+	obj, err := m.layerContents(conf, layers, idx, m.encryptedFields(conf))
+	if err != nil {
+		return err
+	}
+	body, err := encodeLayer(layers[idx].cdc, obj)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFile(layers[idx].file, body, m.backups); err != nil {
+		return err
+	}
+
+	// File system event caused by the write is not going to reload the config
+	bodies, err := readLayers(layers)
+	if err != nil {
+		return err
+	}
+	bodies[idx] = body
+	m.setHash(hashLayers(bodies))
+
+	return nil
+}
+
+// layerList returns config file layers.
+func (m *Manager) layerList() []layer {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.layers
+}
+
+// codec returns the codec used to read and write the config file.
+func (m *Manager) codec() Codec {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.cdc
+}
+
+// reportError logs an error and passes it to the OnError callback.
+func (m *Manager) reportError(err error) {
+	m.logger.Println("Error:", err)
+
+	m.mu.RLock()
+	errorFunc := m.errorFunc
+	m.mu.RUnlock()
+	if errorFunc != nil {
+		errorFunc(err)
+	}
+}
+
+// reportWarning logs a warning and passes it to the OnWarning callback.
+func (m *Manager) reportWarning(err error) {
+	m.logger.Println("Warning:", err)
+
+	m.mu.RLock()
+	warnFunc := m.warnFunc
+	m.mu.RUnlock()
+	if warnFunc != nil {
+		warnFunc(err)
+	}
+}
+
+// updateConfig decodes config file contents on top of the current config and
+// applies them.
+func (m *Manager) updateConfig(body []byte) error {
+	return m.update(func(dupe interface{}) error {
+		return m.codec().Decode(body, dupe)
+	})
+}
+
+// update fills a copy of the current config using the given function,
+// validates it and replaces the current config with it.
+func (m *Manager) update(fill func(dupe interface{}) error) error {
+	m.reload.Lock()
+	defer m.reload.Unlock()
+
+	return m.apply(func(dupe interface{}) (map[string]bool, error) {
+		return nil, fill(dupe)
+	})
+}
+
+// apply is update for callers that hold the reload lock. Paths of decrypted
+// fields returned by fill replace the encrypted fields only if the new config
+// is accepted; nil keeps them.
+func (m *Manager) apply(fill func(dupe interface{}) (map[string]bool, error)) error {
+	// Making a copy of old config for further comparison
+	old := m.Snapshot()
+	// Making a second copy that we will fill with new data
+	dupe := m.Snapshot()
+	m.restorePinned(dupe)
+
+	encrypted, err := fill(dupe)
+	if err != nil {
+		return fmt.Errorf("Failed to update config: %w", err)
+	}
+
+	pinned, err := m.applyOverrides(dupe)
+	if err != nil {
+		return err
+	}
+
+	if err := m.validate(dupe); err != nil {
+		return err
+	}
+
+	// Setting new config
+	m.assign(dupe)
+	m.mu.Lock()
+	m.pinned = pinned
+	// Values that were encrypted before the update are kept out of the log too
+	secrets := make(map[string]bool, len(m.encrypted)+len(encrypted))
+	for path := range m.encrypted {
+		secrets[path] = true
+	}
+	if encrypted != nil {
+		m.encrypted = encrypted
+	}
+	for path := range encrypted {
+		secrets[path] = true
+	}
+	m.mu.Unlock()
+
+	m.triggerCallbacks(old, dupe, secrets)
+
+	return nil
+}
+
+func (m *Manager) triggerCallbacks(oldConf, newConf interface{}, encrypted map[string]bool) {
+	// Don't trigger callbacks on fist load
+	if !m.initialized {
+		m.initialized = true
+		m.mu.RLock()
+		initFunc := m.initFunc
+		m.mu.RUnlock()
+		if initFunc != nil {
+			initFunc()
+		}
+		return
+	}
+
+	changes := diff(oldConf, newConf, m.codec().Tag())
+	m.logChanges(changes, oldConf, newConf, encrypted)
+
+	// Callbacks are called without holding the lock so they could safely
+	// read the config or register more callbacks
+	m.mu.RLock()
+	callbacks := make(map[string][]func(oldVal, newVal interface{}), len(m.callbacks))
+	for fname, cbs := range m.callbacks {
+		callbacks[fname] = cbs
+	}
+	m.mu.RUnlock()
+
+	for fname, d := range changes {
+		if cbs, ok := callbacks[fname]; ok {
+			for _, cb := range cbs {
+				cb(d[0], d[1])
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,186 @@
+package secondly
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func tempConfig(t *testing.T, body string) string {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(file, []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+func testManager(file string) *Manager {
+	return New(File(file), Logger(log.New(ioutil.Discard, "", 0)))
+}
+
+func TestManagerIsolation(t *testing.T) {
+	f1 := tempConfig(t, `{"app_name": "First"}`)
+	defer os.RemoveAll(filepath.Dir(f1))
+	f2 := tempConfig(t, `{"app_name": "Second"}`)
+	defer os.RemoveAll(filepath.Dir(f2))
+
+	var c1, c2 testConf
+	m1, m2 := testManager(f1), testManager(f2)
+	if err := m1.Manage(&c1); err != nil {
+		t.Fatal(err)
+	}
+	if err := m2.Manage(&c2); err != nil {
+		t.Fatal(err)
+	}
+
+	if c1.AppName != "First" {
+		t.Errorf("Expected first config app name to be %q, got %q", "First", c1.AppName)
+	}
+	if c2.AppName != "Second" {
+		t.Errorf("Expected second config app name to be %q, got %q", "Second", c2.AppName)
+	}
+
+	var called bool
+	m1.OnChange("app_name", func(oldVal, newVal interface{}) {
+		called = true
+	})
+	m2.updateConfig([]byte(`{"app_name": "Changed"}`))
+	if called {
+		t.Error("Callback of one manager was triggered by another")
+	}
+	if c1.AppName != "First" {
+		t.Errorf("First config was modified by another manager: %q", c1.AppName)
+	}
+}
+
+func TestConcurrentReload(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "Secondly"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			m.updateConfig([]byte(fmt.Sprintf(`{"database": {"port": %d}}`, i)))
+		}(i)
+		go func() {
+			defer wg.Done()
+			if c := m.Snapshot().(*testConf); c.AppName != "Secondly" {
+				t.Errorf("Expected app name to be %q, got %q", "Secondly", c.AppName)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestManageErrors(t *testing.T) {
+	var conf testConf
+	if err := testManager("/nonexistent/config.json").Manage(&conf); err == nil {
+		t.Error("Expected an error for missing config file")
+	}
+	if err := testManager("").Manage(&conf); err != errNoConfigFile {
+		t.Errorf("Expected %v, got %v", errNoConfigFile, err)
+	}
+	if err := testManager("config.json").Manage(conf); err != errNotStructPtr {
+		t.Errorf("Expected %v, got %v", errNotStructPtr, err)
+	}
+
+	f := tempConfig(t, badJSON)
+	defer os.RemoveAll(filepath.Dir(f))
+	if err := testManager(f).Manage(&conf); err == nil {
+		t.Error("Expected an error for malformed config file")
+	}
+}
+
+func TestReloadError(t *testing.T) {
+	f := tempConfig(t, goodJSON)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	var reported error
+	m.OnError(func(err error) {
+		reported = err
+	})
+
+	if err := ioutil.WriteFile(f, []byte(badJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.readConfig(); err == nil {
+		t.Fatal("Expected an error for malformed config file")
+	} else {
+		m.reportError(err)
+	}
+	if reported == nil {
+		t.Error("Expected error to be reported")
+	}
+	if conf.AppName != "Secondly" {
+		t.Errorf("Expected last good config to be kept, got app name %q", conf.AppName)
+	}
+}
+
+type validatedConf struct {
+	Port int `json:"port"`
+}
+
+func (c *validatedConf) Validate() error {
+	if c.Port <= 0 {
+		return errors.New("port must be positive")
+	}
+
+	return nil
+}
+
+func TestValidation(t *testing.T) {
+	f := tempConfig(t, `{"port": 80}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf validatedConf
+	m := testManager(f)
+	m.Validate(func(newConf interface{}) error {
+		if newConf.(*validatedConf).Port == 8080 {
+			return errors.New("port 8080 is reserved")
+		}
+		return nil
+	})
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, body := range []string{`{"port": 0}`, `{"port": 8080}`} {
+		if err := m.updateConfig([]byte(body)); err == nil {
+			t.Errorf("Expected config %s to be rejected", body)
+		}
+		if conf.Port != 80 {
+			t.Errorf("Expected port to remain 80, got %d", conf.Port)
+		}
+	}
+
+	if err := m.updateConfig([]byte(`{"port": 81}`)); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if conf.Port != 81 {
+		t.Errorf("Expected port to be 81, got %d", conf.Port)
+	}
+}
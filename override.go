@@ -0,0 +1,102 @@
+package secondly
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// override describes a config field pinned by a value that comes from outside
+// of the config file: an environment variable or a command-line flag.
+type override struct {
+	source string      // source describes where the value comes from
+	orig   interface{} // orig is the value that was overridden
+}
+
+// applyOverrides sets config values from environment variables and
+// command-line flags. Flags take precedence over environment variables. It
+// returns overridden fields indexed by path.
+func (m *Manager) applyOverrides(conf interface{}) (map[string]override, error) {
+	pinned := make(map[string]override)
+	if m.env {
+		if err := applyEnv(conf, m.codec().Tag(), m.envPrefix, pinned); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.applyFlags(conf, pinned); err != nil {
+		return nil, err
+	}
+
+	return pinned, nil
+}
+
+// lookupFunc returns an overriding value for a field and a description of
+// its source, or false if the field is not overridden. Elem is true for
+// elements of slices and maps.
+type lookupFunc func(path string, ftyp reflect.StructField, elem bool) (str, source string, ok bool)
+
+// overrideFields sets every field for which lookup returns a value and records
+// it as pinned. If a field is overridden twice, the original value from the
+// config file is kept.
+func overrideFields(conf interface{}, tagName string, pinned map[string]override, lookup lookupFunc) error {
+	var err error
+	walkFields(reflect.ValueOf(conf), tagName, "", func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		if err != nil {
+			return
+		}
+		str, source, ok := lookup(path, ftyp, isElement(ftyp, fval))
+		if !ok {
+			return
+		}
+
+		orig := fval.Interface()
+		if o, ok := pinned[path]; ok {
+			orig = o.orig
+		}
+		if perr := setFromString(fval, str); perr != nil {
+			if isSecret(ftyp) {
+				err = fmt.Errorf("Invalid value of %s", source)
+			} else {
+				err = fmt.Errorf("Invalid value of %s: %w", source, perr)
+			}
+			return
+		}
+		pinned[path] = override{source: source, orig: orig}
+	})
+
+	return err
+}
+
+// pinnedFields returns fields overridden by environment variables or flags
+// indexed by path.
+func (m *Manager) pinnedFields() map[string]override {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.pinned
+}
+
+// restorePinned reverts overridden fields to the values they had before the
+// override.
+func (m *Manager) restorePinned(conf interface{}) {
+	for path, o := range m.pinnedFields() {
+		setField(conf, m.codec().Tag(), path, o.orig)
+	}
+}
+
+// checkPinned returns an error if any of the values sets a pinned field.
+func (m *Manager) checkPinned(values map[string]string) error {
+	var errs ValidationError
+	for path, o := range m.pinnedFields() {
+		if _, ok := values[path]; ok {
+			errs = append(errs, FieldError{
+				Path: path,
+				Msg:  "is set by " + o.source,
+			})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package secondly
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Field paths look like database.port, servers[0].host or labels.region.
+// Keys that can't be told apart from the path syntax, like the
+// app.kubernetes.io/name label, are quoted: labels["app.kubernetes.io/name"].
+// Quoted keys are JSON strings, so the web GUI could parse them too.
+
+// joinPath appends a key of a struct field or a map element to a path.
+func joinPath(path, key string) string {
+	if !isPlainKey(key) {
+		return path + "[" + quoteKey(key) + "]"
+	}
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// indexPath appends an index of a slice element to a path.
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// isPlainKey returns true if a key can be used in a path without quoting.
+func isPlainKey(key string) bool {
+	return key != "" && !strings.ContainsAny(key, `.[]"`)
+}
+
+func quoteKey(key string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(key)
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// splitPath splits a path into tokens: servers[2].host becomes servers, [2]
+// and host, labels["a.b"] becomes labels and ["a.b"]. Malformed paths are
+// split as far as possible.
+func splitPath(path string) []string {
+	var tokens []string
+	for path != "" {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			continue
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if strings.HasPrefix(path, `["`) {
+				if quoted, err := strconv.QuotedPrefix(path[1:]); err == nil {
+					end = len(quoted) + 1
+				}
+			}
+			if end < 0 {
+				end = len(path) - 1
+			}
+			tokens = append(tokens, path[:end+1])
+			path = path[end+1:]
+		default:
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			tokens = append(tokens, path[:end])
+			path = path[end:]
+		}
+	}
+
+	return tokens
+}
+
+// joinTokens builds a path from tokens returned by splitPath.
+func joinTokens(tokens []string) string {
+	var path string
+	for i, tok := range tokens {
+		if i > 0 && tok[0] != '[' {
+			path += "."
+		}
+		path += tok
+	}
+
+	return path
+}
+
+// tokenKey returns the key a path token stands for: a field name, a map key
+// or a slice index.
+func tokenKey(tok string) string {
+	if !strings.HasPrefix(tok, "[") {
+		return tok
+	}
+	inner := strings.TrimSuffix(tok[1:], "]")
+	if key, err := strconv.Unquote(inner); err == nil {
+		return key
+	}
+
+	return inner
+}
+
+// isIndexToken returns true for tokens that stand for slice indexes.
+func isIndexToken(tok string) bool {
+	return strings.HasPrefix(tok, "[") && !strings.HasPrefix(tok, `["`)
+}
@@ -0,0 +1,41 @@
+package secondly
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitPath(t *testing.T) {
+	tests := map[string][]string{
+		"database.port":                    {"database", "port"},
+		"servers[2].host":                  {"servers", "[2]", "host"},
+		`labels["app.kubernetes.io/name"]`: {"labels", `["app.kubernetes.io/name"]`},
+		`labels["a\"]b"].x`:                {"labels", `["a\"]b"]`, "x"},
+		`["a.b"]`:                          {`["a.b"]`},
+	}
+	for path, exp := range tests {
+		tokens := splitPath(path)
+		if !reflect.DeepEqual(tokens, exp) {
+			t.Errorf("Expected %s to split into %q, got %q", path, exp, tokens)
+		}
+		if joined := joinTokens(tokens); joined != path {
+			t.Errorf("Expected tokens of %s to join back, got %s", path, joined)
+		}
+	}
+}
+
+func TestJoinPath(t *testing.T) {
+	for _, key := range []string{"region", "a.b", "x[0]", `q"`, ""} {
+		path := joinPath("labels", key)
+		tokens := splitPath(path)
+		if len(tokens) != 2 || tokenKey(tokens[1]) != key {
+			t.Errorf("Expected %s to end with key %q, got %q", path, key, tokens)
+		}
+	}
+	if path := joinPath("", "a.b"); path != `["a.b"]` {
+		t.Errorf(`Expected ["a.b"], got %s`, path)
+	}
+	if name := envName("app", `labels["a.b"]`); name != "APP_LABELS_A_B" {
+		t.Errorf("Expected APP_LABELS_A_B, got %s", name)
+	}
+}
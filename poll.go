@@ -0,0 +1,86 @@
+package secondly
+
+import (
+	"context"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// defaultPollInterval is used when file system events are not available and
+// the manager falls back to polling.
+const defaultPollInterval = 5 * time.Second
+
+// filePoller reloads the config when any of the config files changes. It is
+// used where file system events don't arrive, like NFS mounts.
+type filePoller struct {
+	m        *Manager
+	interval time.Duration
+	states   map[string]fileState
+	done     chan struct{}
+}
+
+// fileState describes a config file at the time it was checked. Modification
+// time alone is not enough: it may have a coarse resolution, so the contents
+// are compared too.
+type fileState struct {
+	target  string // target is the file the config file resolves to
+	exists  bool
+	modTime time.Time
+	size    int64
+	hash    [sha256.Size]byte
+}
+
+func newFilePoller(m *Manager, interval time.Duration) *filePoller {
+	p := &filePoller{m: m, interval: interval, done: make(chan struct{})}
+	p.states = p.check()
+
+	return p
+}
+
+// check returns the current states of config files.
+func (p *filePoller) check() map[string]fileState {
+	states := make(map[string]fileState)
+	for _, l := range p.m.layerList() {
+		st := fileState{target: resolveFile(l.file)}
+		if info, err := os.Stat(st.target); err == nil {
+			st.exists, st.modTime, st.size = true, info.ModTime(), info.Size()
+			if body, err := ioutil.ReadFile(st.target); err == nil {
+				st.hash = sha256.Sum256(body)
+			}
+		}
+		states[l.file] = st
+	}
+
+	return states
+}
+
+func (p *filePoller) run() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.done:
+			return
+		}
+
+		states := p.check()
+		changed := len(states) != len(p.states)
+		for file, st := range states {
+			changed = changed || st != p.states[file]
+		}
+		p.states = states
+
+		if changed {
+			p.m.reloadChanged()
+		}
+	}
+}
+
+func (p *filePoller) stop(ctx context.Context) error {
+	close(p.done)
+	return nil
+}
@@ -0,0 +1,66 @@
+package secondly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPollFiles(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "one"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+	info, err := os.Stat(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, logs := startedManager(t, f, func(m *Manager) {
+		m.PollFiles(10 * time.Millisecond)
+	})
+
+	// Nothing changed, nothing to reload
+	time.Sleep(50 * time.Millisecond)
+	if strings.Contains(logs.String(), "reloading") {
+		t.Fatalf("Unexpected reload: %s", logs.String())
+	}
+
+	// Same size and modification time, only contents differ
+	if err := ioutil.WriteFile(f, []byte(`{"app_name": "two"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(f, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "modified file to be reloaded", func() bool {
+		return appName(m) == "two"
+	})
+}
+
+func TestPollFallback(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "one"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	// Directory of the second layer doesn't exist, so it can't be watched
+	var conf testConf
+	var warning error
+	m := testManager("")
+	PollInterval(10 * time.Millisecond)(m)
+	m.OnWarning(func(err error) { warning = err })
+	if err := m.Manage(&conf, f, filepath.Join(filepath.Dir(f), "missing", "local.json")); err != nil {
+		t.Fatal(err)
+	}
+	m.HandleFileSystemEvents()
+	t.Cleanup(m.Stop)
+	if warning == nil || !strings.Contains(warning.Error(), "polling them every 10ms") {
+		t.Errorf("Expected a warning about polling, got %v", warning)
+	}
+
+	if err := ioutil.WriteFile(f, []byte(`{"app_name": "two"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "modified file to be reloaded", func() bool {
+		return appName(m) == "two"
+	})
+}
@@ -5,28 +5,23 @@
 package secondly
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"flag"
-	"fmt"
 	"log"
-	"os"
-	"os/signal"
-	"path/filepath"
 	"reflect"
-	"strings"
-	"syscall"
-
-	"github.com/howeyc/fsnotify"
+	"time"
 )
 
-var (
-	config      interface{} // config stores application config
-	configFile  string
-	callbacks   = make(map[string][]func(oldVal, newVal interface{}))
-	initialized bool
-	initFunc    func()
-)
+// std is the default manager used by package-level functions.
+var std = New()
+
+// Configure applies options to the default manager. It must be called before
+// Manage.
+func Configure(opts ...Option) {
+	for _, opt := range opts {
+		opt(std)
+	}
+}
 
 // SetupFlags sets up Secondly's configuration flags.
 func SetupFlags() {
@@ -34,182 +29,105 @@ func SetupFlags() {
 		log.Fatalln("secondly.SetupFlags() must be called before flag.Parse()")
 	}
 
-	flag.StringVar(&configFile, "config", "config.json", "Path to config file")
+	flag.Var((*fileList)(&std.files), "config", "Path to config file, multiple comma-separated files are merged in order")
 }
 
-// Manage accepts a pointer to a configuration struct.
-func Manage(target interface{}) {
-	if ok := isStructPtr(target); !ok {
-		panic("Argument must be a pointer to a struct")
+// SetupFieldFlags sets up a command-line flag for every field of the config
+// struct, e.g. -database.port. Flag values override values from the config
+// file. Flags are named for the format set with the Format option or the
+// format of the default config file.
+func SetupFieldFlags(target interface{}) error {
+	if flag.Parsed() {
+		log.Fatalln("secondly.SetupFieldFlags() must be called before flag.Parse()")
 	}
 
-	assign(target)
+	return std.SetupFieldFlags(flag.CommandLine, target)
+}
+
+// Manage accepts a pointer to a configuration struct and loads the config file
+// into it. If multiple files are given, they are merged in order. An error is
+// returned if the initial load fails.
+func Manage(target interface{}, files ...string) error {
+	return std.Manage(target, files...)
+}
 
-	bootstrap()
+// Snapshot returns a pointer to a copy of the current configuration that is
+// safe to read concurrently with reloads.
+func Snapshot() interface{} {
+	return std.Snapshot()
 }
 
 // StartServer will start an HTTP server with web interface to edit config.
 func StartServer(host string, port int) {
-	go startServer(fmt.Sprintf("%s:%d", host, port))
+	std.StartServer(host, port)
 }
 
 // HandleSIGHUP waits a SIGHUP system call and reloads configuration when
 // receives one.
 func HandleSIGHUP() {
-	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, syscall.SIGHUP)
-	go func() {
-		for range ch {
-			log.Println("SIGHUP received, reloading config")
-			readConfig()
-		}
-	}()
+	std.HandleSIGHUP()
 }
 
 // HandleFileSystemEvents listens to file system events and reloads configuration when
-// config file is modified.
+// config file is modified. If file system events are not available, config
+// files are polled instead.
 func HandleFileSystemEvents() {
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		panic(err)
-	}
-	if err := watcher.WatchFlags(filepath.Dir(configFile), fsnotify.FSN_MODIFY); err != nil {
-		panic(err)
-	}
-
-	fname := configFile
-	if ss := strings.Split(configFile, "/"); len(ss) > 1 {
-		fname = ss[len(ss)-1]
-	}
-
-	go func() {
-		for {
-			select {
-			case e := <-watcher.Event:
-				if e.Name != fname {
-					continue
-				}
-				log.Println("Config file was modified, reloading")
-				readConfig()
-			case err := <-watcher.Error:
-				log.Println("fsnotify error:", err)
-			}
-		}
-	}()
+	std.HandleFileSystemEvents()
 }
 
-// OnLoad sets up a callback function that would be called once configuration
-// is loaded for the first time.
-func OnLoad(fun func()) {
-	initFunc = fun
+// Close stops handling signals and file system events and gracefully shuts
+// down the web server. It waits for reloads and callbacks that are in
+// progress, or until the context is done.
+func Close(ctx context.Context) error {
+	return std.Close(ctx)
 }
 
-// OnChange adds a callback function that is triggered every time a value of
-// a field changes. Field must be a json tag of the struct field.
-func OnChange(field string, fun func(oldVal, newVal interface{})) {
-	callbacks[field] = append(callbacks[field], fun)
+// Stop closes the default manager without a deadline.
+func Stop() {
+	std.Stop()
 }
 
-// asign is responsible for assigning new config value. It is complicated
-// because we're changing the value of an interface which is defined in
-// another package.
-func assign(target interface{}) {
-	if config == nil {
-		config = target
-		return
-	}
-
-	cval := reflect.ValueOf(config).Elem()
-	tval := reflect.ValueOf(target).Elem()
-	cval.Set(tval)
+// Hash returns a hex encoded SHA-256 hash of the config files the current
+// config was loaded from or saved to.
+func Hash() string {
+	return std.Hash()
 }
 
-// bootstrap sets up initial configuration.
-func bootstrap() {
-	if configFile == "" {
-		log.Fatalln("path to config file is not set")
-	}
-	if fileExist(configFile) {
-		log.Println("Loading config file")
-		readConfig()
-	} else {
-		log.Fatalln("Config file not found")
-	}
+// PollFiles checks config files for changes at the given interval and reloads
+// configuration when any of them is modified.
+func PollFiles(interval time.Duration) {
+	std.PollFiles(interval)
 }
 
-func readConfig() {
-	body, err := readFile(configFile)
-	if err != nil {
-		panic(err)
-	}
-	updateConfig(body)
+// OnLoad sets up a callback function that would be called once configuration
+// is loaded for the first time.
+func OnLoad(fun func()) {
+	std.OnLoad(fun)
 }
 
-func writeConfig() {
-	if err := writeFile(configFile, marshal(config)); err != nil {
-		panic(err)
-	}
+// OnChange adds a callback function that is triggered every time a value of
+// a field changes. Field is the path of the field as it appears in the config
+// file, e.g. database.port.
+func OnChange(field string, fun func(oldVal, newVal interface{})) {
+	std.OnChange(field, fun)
 }
 
-func updateConfig(body []byte) {
-	// Making a copy of old config for further comparison
-	old := duplicate(config)
-	// Making a second copy that we will fill with new data
-	dupe := duplicate(config)
-
-	if err := json.Unmarshal(body, dupe); err != nil {
-		panic("Failed to update config")
-		return
-	}
-
-	// Setting new config
-	assign(dupe)
-
-	triggerCallbacks(old, dupe)
+// OnWarning sets up a callback function that is called when a config is
+// loaded, but has problems, like unknown keys in lenient mode.
+func OnWarning(fun func(err error)) {
+	std.OnWarning(fun)
 }
 
-func marshal(obj interface{}) []byte {
-	body, err := json.Marshal(config)
-	if err != nil {
-		panic(err)
-	}
-	out := bytes.NewBuffer([]byte{})
-
-	// Indent with empty prefix and four spaces
-	if err = json.Indent(out, body, "", "    "); err != nil {
-		panic(err)
-	}
-
-	// Adding a trailing newline
-	// It's good for your carma
-	out.WriteByte('\n')
-
-	return out.Bytes()
+// Validate adds a function that checks a candidate config before it is
+// applied. If it returns an error the reload is rejected.
+func Validate(fun func(newConf interface{}) error) {
+	std.Validate(fun)
 }
 
-func triggerCallbacks(oldConf, newConf interface{}) {
-	// Don't trigger callbacks on fist load
-	if !initialized {
-		initialized = true
-		if initFunc != nil {
-			initFunc()
-		}
-		return
-	}
-
-	if len(callbacks) == 0 {
-		return
-	}
-
-	for fname, d := range diff(oldConf, newConf) {
-		if cbs, ok := callbacks[fname]; ok {
-			for _, cb := range cbs {
-				cb(d[0], d[1])
-			}
-		}
-	}
-
-	return
+// OnError sets up a callback function that is called when a config reload
+// fails. The last good configuration is kept in place in that case.
+func OnError(fun func(err error)) {
+	std.OnError(fun)
 }
 
 // Secondly accepts only a pointer to stuct as its config value. Here we're
@@ -225,7 +143,8 @@ func isStructPtr(target interface{}) bool {
 }
 
 // duplicate creates a copy of a value behind the config interface. Such copies
-// are used to replace config value and to check for changes.
+// are used to replace config value and to check for changes. Slices and maps
+// are copied too, so a copy never shares memory with the original.
 func duplicate(original interface{}) interface{} {
 	// Get the interface value
 	val := reflect.ValueOf(original)
@@ -236,7 +155,58 @@ func duplicate(original interface{}) interface{} {
 	// Creating a duplicate instance of that struct
 	dupe := reflect.New(typ)
 	// Value copy
-	dupe.Elem().Set(val)
+	dupe.Elem().Set(deepCopy(val))
 
 	return dupe.Interface()
 }
+
+// deepCopy copies a value along with all slices, maps and pointers it refers
+// to.
+func deepCopy(val reflect.Value) reflect.Value {
+	switch val.Kind() {
+	case reflect.Struct:
+		dupe := reflect.New(val.Type()).Elem()
+		// Unexported fields are copied as is
+		dupe.Set(val)
+		for i := 0; i < val.NumField(); i++ {
+			if f := dupe.Field(i); f.CanSet() {
+				f.Set(deepCopy(val.Field(i)))
+			}
+		}
+		return dupe
+	case reflect.Array:
+		dupe := reflect.New(val.Type()).Elem()
+		for i := 0; i < val.Len(); i++ {
+			dupe.Index(i).Set(deepCopy(val.Index(i)))
+		}
+		return dupe
+	case reflect.Slice:
+		if val.IsNil() {
+			return val
+		}
+		dupe := reflect.MakeSlice(val.Type(), val.Len(), val.Len())
+		for i := 0; i < val.Len(); i++ {
+			dupe.Index(i).Set(deepCopy(val.Index(i)))
+		}
+		return dupe
+	case reflect.Map:
+		if val.IsNil() {
+			return val
+		}
+		dupe := reflect.MakeMapWithSize(val.Type(), val.Len())
+		iter := val.MapRange()
+		for iter.Next() {
+			dupe.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return dupe
+	case reflect.Ptr:
+		if val.IsNil() {
+			return val
+		}
+		dupe := reflect.New(val.Type().Elem())
+		dupe.Elem().Set(deepCopy(val.Elem()))
+		return dupe
+	default:
+		return val
+	}
+}
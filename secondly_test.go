@@ -51,7 +51,7 @@ func TestUnmarshal(t *testing.T) {
 		t.Errorf("Expected Foo to equal %q, got %q", "Secondly", conf.AppName)
 	}
 	if conf.Version != 1 {
-		t.Errorf("Expected Bar to equal %q, got %q", 1, conf.Version)
+		t.Errorf("Expected Bar to equal %v, got %v", 1, conf.Version)
 	}
 }
 
@@ -63,3 +63,13 @@ func TestDuplicate(t *testing.T) {
 		t.Error("Duplication failed")
 	}
 }
+
+func TestConfigure(t *testing.T) {
+	defer func(m *Manager) { std = m }(std)
+	std = New()
+
+	Configure(Env("app"), Strict(), Backups(3))
+	if !std.env || std.envPrefix != "app" || std.unknownKeys != rejectUnknownKeys || std.backups != 3 {
+		t.Errorf("Expected options to be applied to the default manager, got %+v", std)
+	}
+}
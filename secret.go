@@ -0,0 +1,86 @@
+package secondly
+
+import (
+	"reflect"
+	"sort"
+)
+
+// secretMask replaces values of secret fields in the web GUI. When the GUI
+// submits the mask, the field keeps its value.
+const secretMask = "********"
+
+// isSecret returns true for fields marked with the secret or the encrypted
+// option, e.g. `secondly:"secret"`.
+func isSecret(ftyp reflect.StructField) bool {
+	opts := parseTag(ftyp.Tag.Get(optionsTag))
+	_, secret := opts["secret"]
+	_, encrypted := opts["encrypted"]
+	return secret || encrypted
+}
+
+// maskSecret hides a value of a secret field. Unset and empty values are not
+// hidden, so that it is clear whether the field is set.
+func maskSecret(val interface{}) interface{} {
+	if val == nil || val == "" {
+		return val
+	}
+
+	return secretMask
+}
+
+// secretFields returns paths of secret fields of the current config,
+// including fields that were encrypted in config files.
+func (m *Manager) secretFields() map[string]bool {
+	m.mu.RLock()
+	secrets := make(map[string]bool, len(m.encrypted))
+	for path := range m.encrypted {
+		secrets[path] = true
+	}
+	m.mu.RUnlock()
+
+	for _, f := range extractFields(m.Snapshot(), m.codec().Tag(), "") {
+		if f.Secret {
+			secrets[f.Path] = true
+		}
+	}
+
+	return secrets
+}
+
+// dropMasked removes values of secret fields that were submitted unchanged.
+func dropMasked(values map[string]string, secrets map[string]bool) {
+	for path, val := range values {
+		if val == secretMask && secrets[path] {
+			delete(values, path)
+		}
+	}
+}
+
+// logChanges writes changed fields to the log. Values of secret fields and
+// of the given encrypted fields are not logged.
+func (m *Manager) logChanges(changes map[string][]interface{}, oldConf, newConf interface{}, encrypted map[string]bool) {
+	// Removed elements are only present in the old config
+	secrets := make(map[string]bool, len(encrypted))
+	for path := range encrypted {
+		secrets[path] = true
+	}
+	for _, conf := range []interface{}{oldConf, newConf} {
+		for _, f := range extractFields(conf, m.codec().Tag(), "") {
+			secrets[f.Path] = secrets[f.Path] || f.Secret
+		}
+	}
+
+	paths := make([]string, 0, len(changes))
+	for path := range changes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if secrets[path] {
+			m.logger.Printf("Field %s changed\n", path)
+		} else {
+			m.logger.Printf("Field %s changed from %v to %v\n", path, formatValue(changes[path][0]), formatValue(changes[path][1]))
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package secondly
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type secretConf struct {
+	User     string `json:"user"`
+	Password string `json:"password" secondly:"secret"`
+	Port     int    `json:"port" secondly:"secret"`
+}
+
+func TestSecretFields(t *testing.T) {
+	f := tempConfig(t, `{"user": "root", "password": "hunter2", "port": 3306}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf secretConf
+	var logs bytes.Buffer
+	m := testManager(f)
+	Logger(log.New(&logs, "", 0))(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	rw := httptest.NewRecorder()
+	m.fieldsHandler(rw, httptest.NewRequest("GET", "/fields.json", nil))
+	if strings.Contains(rw.Body.String(), "hunter2") {
+		t.Errorf("Expected password to be masked, got %s", rw.Body.String())
+	}
+	var fields []field
+	if err := json.Unmarshal(rw.Body.Bytes(), &fields); err != nil {
+		t.Fatal(err)
+	}
+	if f := indexFields(fields)["password"]; f.Value != secretMask || !f.Secret {
+		t.Errorf("Expected password to be a masked secret, got %+v", f)
+	}
+
+	save := func(body string) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(body)))
+		return rw
+	}
+
+	// Submitting the mask keeps the value
+	if rw := save(`{"user": "admin", "password": "********", "port": "********"}`); rw.Code != http.StatusOK {
+		t.Fatalf("Expected save to succeed, got %d: %s", rw.Code, rw.Body.String())
+	}
+	if conf.User != "admin" || conf.Password != "hunter2" || conf.Port != 3306 {
+		t.Errorf("Expected secrets to be kept, got %+v", conf)
+	}
+
+	if rw := save(`{"password": "correct horse", "port": "many"}`); rw.Code != http.StatusUnprocessableEntity || strings.Contains(rw.Body.String(), "many") {
+		t.Errorf("Expected invalid secret value not to be echoed, got %d: %s", rw.Code, rw.Body.String())
+	}
+
+	if rw := save(`{"password": "correct horse"}`); rw.Code != http.StatusOK {
+		t.Fatalf("Expected save to succeed, got %d: %s", rw.Code, rw.Body.String())
+	}
+	if conf.Password != "correct horse" {
+		t.Errorf("Expected password to change, got %q", conf.Password)
+	}
+
+	out := logs.String()
+	if !strings.Contains(out, "Field password changed\n") || !strings.Contains(out, "Field user changed from root to admin") {
+		t.Errorf("Expected changes to be logged, got %s", out)
+	}
+	if strings.Contains(out, "hunter2") || strings.Contains(out, "correct horse") {
+		t.Errorf("Expected secrets not to be logged, got %s", out)
+	}
+}
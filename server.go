@@ -1,20 +1,24 @@
 package secondly
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/GeertJohan/go.rice"
 )
 
-func startServer(addr string) {
+func (m *Manager) startServer(addr string) {
 	staticHandler := http.FileServer(rice.MustFindBox("static").HTTPBox())
 
 	mux := http.NewServeMux()
-	mux.HandleFunc("/fields.json", fieldsHandler)
-	mux.HandleFunc("/save", saveHandler)
+	mux.HandleFunc("/fields.json", m.fieldsHandler)
+	mux.HandleFunc("/save", m.saveHandler)
+	mux.HandleFunc("/hash.json", m.hashHandler)
 
 	// Static
 	mux.Handle("/app.js", staticHandler)
@@ -27,36 +31,161 @@ func startServer(addr string) {
 		}
 	})
 
-	log.Println("Starting configuration server on", addr)
-	go http.ListenAndServe(addr, mux)
+	srv := &http.Server{Addr: addr, Handler: mux}
+	run := func() {
+		m.logger.Println("Starting configuration server on", addr)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			m.reportError(fmt.Errorf("Configuration server failed: %w", err))
+		}
+	}
+	m.background(run, srv.Shutdown)
 }
 
-func fieldsHandler(rw http.ResponseWriter, req *http.Request) {
-	fields := extractFields(config, "")
+func (m *Manager) fieldsHandler(rw http.ResponseWriter, req *http.Request) {
+	fields := formFields(m.Snapshot(), m.codec().Tag())
+	defaults := indexFields(extractFields(m.defaultConfig(), m.codec().Tag(), ""))
+	pinned := m.pinnedFields()
+	secrets := m.secretFields()
+	for i, f := range fields {
+		fields[i].Default = formatValue(defaults[f.Path].Value)
+		if secrets[f.Path] {
+			fields[i].Secret = true
+			fields[i].Value = maskSecret(f.Value)
+			fields[i].Default = maskSecret(fields[i].Default)
+		}
+		if o, ok := pinned[f.Path]; ok {
+			fields[i].PinnedBy = o.source
+		}
+	}
+
 	body, err := json.Marshal(fields)
 	if err != nil {
-		panic(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
 	rw.Write(body)
 }
 
-func saveHandler(rw http.ResponseWriter, req *http.Request) {
+func (m *Manager) hashHandler(rw http.ResponseWriter, req *http.Request) {
+	body, _ := json.Marshal(struct {
+		Hash string `json:"hash"`
+	}{
+		Hash: m.Hash(),
+	})
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Write(body)
+}
+
+func (m *Manager) saveHandler(rw http.ResponseWriter, req *http.Request) {
 	cbody, err := ioutil.ReadAll(req.Body)
 	if err != nil {
-		panic(err)
+		writeResponse(rw, http.StatusBadRequest, err.Error(), nil)
+		return
 	}
 
-	updateConfig(cbody)
-	writeConfig()
+	values, shape, err := flattenPayload(cbody)
+	if err != nil {
+		writeResponse(rw, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	dropMasked(values, m.secretFields())
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	if err := m.checkKeys(keys); err != nil {
+		m.reportError(err)
+		writeResponse(rw, http.StatusUnprocessableEntity, err.Error(), nil)
+		return
+	}
+	if err := m.checkPinned(values); err != nil {
+		writeResponse(rw, http.StatusUnprocessableEntity, err.Error(), err.(ValidationError))
+		return
+	}
+
+	// Reloads must not run between the update and the write
+	m.reload.Lock()
+	defer m.reload.Unlock()
+
+	err = m.apply(func(dupe interface{}) (map[string]bool, error) {
+		resizeContainers(dupe, m.codec().Tag(), shape)
+		return nil, setFields(dupe, m.codec().Tag(), values)
+	})
+	if err != nil {
+		m.reportError(err)
+		// Field errors are passed to the UI so it could highlight them
+		var verr ValidationError
+		errors.As(err, &verr)
+		writeResponse(rw, http.StatusUnprocessableEntity, err.Error(), verr)
+		return
+	}
+	if err := m.writeConfig(); err != nil {
+		m.reportError(err)
+		writeResponse(rw, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+
+	writeResponse(rw, http.StatusOK, "Config successfully updated", nil)
+}
+
+// flattenPayload converts a JSON object sent by the web GUI into a map of
+// string values indexed by field path. It also returns element keys of all
+// nested objects and arrays indexed by path, which define the shape of slices
+// and maps.
+func flattenPayload(body []byte) (values map[string]string, shape map[string][]string, err error) {
+	var payload map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&payload); err != nil {
+		return nil, nil, err
+	}
+
+	values = make(map[string]string)
+	shape = make(map[string][]string)
+	var flatten func(val interface{}, path string)
+	flatten = func(val interface{}, path string) {
+		switch v := val.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key, elem := range v {
+				keys = append(keys, key)
+				flatten(elem, joinPath(path, key))
+			}
+			shape[path] = keys
+		case []interface{}:
+			keys := make([]string, len(v))
+			for i, elem := range v {
+				keys[i] = strconv.Itoa(i)
+				flatten(elem, indexPath(path, i))
+			}
+			shape[path] = keys
+		case nil:
+			// Null clears optional fields
+			shape[path] = nil
+		case bool:
+			values[path] = strconv.FormatBool(v)
+		default:
+			values[path] = fmt.Sprint(v)
+		}
+	}
+	flatten(payload, "")
+
+	return values, shape, nil
+}
 
+func writeResponse(rw http.ResponseWriter, status int, msg string, errs ValidationError) {
 	resp := struct {
-		Success bool   `json:"success"`
-		Msg     string `json:"msg"`
+		Success bool            `json:"success"`
+		Msg     string          `json:"msg"`
+		Errors  ValidationError `json:"errors,omitempty"`
 	}{
-		Success: true,
-		Msg:     "Config successfully updated",
+		Success: status == http.StatusOK,
+		Msg:     msg,
+		Errors:  errs,
 	}
 	body, _ := json.Marshal(resp)
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
 	rw.Write(body)
 }
@@ -0,0 +1,183 @@
+package secondly
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFlattenPayload(t *testing.T) {
+	values, _, err := flattenPayload([]byte(`{"app_name": "Secondly", "version": 1.5, "database": {"port": 3306, "ssl": true}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := map[string]string{
+		"app_name":      "Secondly",
+		"version":       "1.5",
+		"database.port": "3306",
+		"database.ssl":  "true",
+	}
+	for path, val := range exp {
+		if values[path] != val {
+			t.Errorf("Expected %s to equal %q, got %q", path, val, values[path])
+		}
+	}
+}
+
+func TestSaveHandler(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "Secondly", "database": {"port": 3306}}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	save := func(body string) (int, map[string]interface{}) {
+		rw := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/save", strings.NewReader(body))
+		m.saveHandler(rw, req)
+
+		var resp map[string]interface{}
+		if err := json.Unmarshal(rw.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		return rw.Code, resp
+	}
+
+	if code, resp := save(`{"database": {"port": 5432}}`); code != http.StatusOK {
+		t.Errorf("Expected save to succeed, got %d: %v", code, resp["msg"])
+	}
+	if conf.Database.Port != 5432 || conf.AppName != "Secondly" {
+		t.Errorf("Unexpected config after save: %+v", conf)
+	}
+
+	code, resp := save(`{"database": {"port": "many"}}`)
+	if code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected save to fail with %d, got %d", http.StatusUnprocessableEntity, code)
+	}
+	if errs, ok := resp["errors"].([]interface{}); !ok || len(errs) != 1 {
+		t.Errorf("Expected a single field error, got %v", resp["errors"])
+	}
+	if conf.Database.Port != 5432 {
+		t.Errorf("Expected database port to remain 5432, got %d", conf.Database.Port)
+	}
+}
+
+func TestSaveContainers(t *testing.T) {
+	f := tempConfig(t, `{"servers": [{"host": "a", "port": 1}, {"host": "b", "port": 2}], "labels": {"region": "eu"}}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf containerConf
+	m := testManager(f)
+	Strict()(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	rw := httptest.NewRecorder()
+	body := `{"servers": [{"host": "b", "port": 3}], "tags": ["x", "y"], "labels": {"zone": "a"}}`
+	m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(body)))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("Expected save to succeed, got %d: %s", rw.Code, rw.Body.String())
+	}
+
+	if len(conf.Servers) != 1 || conf.Servers[0] != (containerServerConf{"b", 3}) {
+		t.Errorf("Unexpected servers after save: %+v", conf.Servers)
+	}
+	if len(conf.Tags) != 2 || conf.Tags[1] != "y" {
+		t.Errorf("Unexpected tags after save: %+v", conf.Tags)
+	}
+	if len(conf.Labels) != 1 || conf.Labels["zone"] != "a" {
+		t.Errorf("Unexpected labels after save: %+v", conf.Labels)
+	}
+
+	var saved containerConf
+	fbody, err := ioutil.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(fbody, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Servers) != 1 || saved.Labels["zone"] != "a" {
+		t.Errorf("Unexpected config file contents: %+v", saved)
+	}
+}
+
+func TestSaveQuotedKeys(t *testing.T) {
+	f := tempConfig(t, `{"labels": {"app.kubernetes.io/name": "web", "region": "eu"}}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf containerConf
+	m := testManager(f)
+	Strict()(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	var path string
+	for _, f := range formFields(m.Snapshot(), "json") {
+		if f.Name == "app.kubernetes.io/name" {
+			path = f.Path
+		}
+	}
+	if path != `labels["app.kubernetes.io/name"]` {
+		t.Errorf("Expected the key to be quoted in the path, got %q", path)
+	}
+
+	rw := httptest.NewRecorder()
+	body := `{"labels": {"app.kubernetes.io/name": "api", "a[0]": "b"}}`
+	m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(body)))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("Expected save to succeed, got %d: %s", rw.Code, rw.Body.String())
+	}
+
+	exp := map[string]string{"app.kubernetes.io/name": "api", "a[0]": "b"}
+	if !reflect.DeepEqual(conf.Labels, exp) {
+		t.Errorf("Unexpected labels after save: %+v", conf.Labels)
+	}
+	m.setHash("")
+	if err := m.readConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(conf.Labels, exp) {
+		t.Errorf("Unexpected labels after reload: %+v", conf.Labels)
+	}
+}
+
+func TestSaveOptionalFields(t *testing.T) {
+	f := tempConfig(t, `{"timeout": 5}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf optionalConf
+	m := testManager(f)
+	Strict()(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Timeout == nil || *conf.Timeout != 5 || conf.Database != nil {
+		t.Fatalf("Unexpected config: %+v", conf)
+	}
+
+	rw := httptest.NewRecorder()
+	body := `{"timeout": null, "database": {"host": "localhost"}}`
+	m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(body)))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("Expected save to succeed, got %d: %s", rw.Code, rw.Body.String())
+	}
+	if conf.Timeout != nil {
+		t.Errorf("Expected timeout to be cleared, got %d", *conf.Timeout)
+	}
+	if conf.Database == nil || conf.Database.Host != "localhost" {
+		t.Errorf("Expected database to be set, got %+v", conf.Database)
+	}
+}
@@ -26,9 +26,9 @@ function saveFields(payload, callback) {
     xhr.setRequestHeader("Content-Type", "application/json; charset=utf-8");
     xhr.onreadystatechange = function() {
         if (xhr.readyState === 4) {
-            if (xhr.status === 200) {
+            try {
                 callback(JSON.parse(xhr.responseText));
-            } else {
+            } catch (e) {
                 callback({"success": false, "msg": "Failed to save config"})
             }
         }
@@ -36,35 +36,100 @@ function saveFields(payload, callback) {
     xhr.send(JSON.stringify(payload));
 }
 
+// Slices, maps and optional sections indexed by path
+var containers = {};
+
+// Splits a path like servers[2].host into tokens: servers, [2], host. Keys
+// that contain dots, brackets or quotes are quoted: labels["a.b"]
+function splitPath(path) {
+    return path.match(/\["(?:[^"\\]|\\.)*"\]|\[\d+\]|[^.\[\]"]+/g) || [];
+}
+
+// Returns a field name, a map key or a slice index the token stands for
+function tokenKey(token) {
+    if (token.indexOf('["') === 0) {
+        return JSON.parse(token.slice(1, -1));
+    } else if (token[0] === "[") {
+        return parseInt(token.slice(1, -1), 10);
+    }
+    return token;
+}
+
+// Returns a path suffix for a map key, quoting it if needed
+function keySuffix(key) {
+    if (/^[^.\[\]"]+$/.test(key)) {
+        return "."+ key;
+    }
+    return "["+ JSON.stringify(key) +"]";
+}
+
+function joinPath(tokens) {
+    var path = "";
+    for (var i = 0; i < tokens.length; i++) {
+        if (i > 0 && tokens[i][0] !== "[") {
+            path += ".";
+        }
+        path += tokens[i];
+    }
+    return path;
+}
+
 function drawForm(fields) {
+    render(makeRows(fields, {}), null);
+}
+
+function makeRows(fields, titlesPrinted) {
     var elems = [];
 
-    var curLevel = 0;
-    var titlesPrinted = {};
     for (var i = 0; i < fields.length; i++) {
         var field = fields[i];
 
-        var tokens = field.path.split(".");
-        var section = tokens.slice(0, -1).join(".");
+        var tokens = splitPath(field.path);
+        var section = joinPath(tokens.slice(0, -1));
 
         if (section != "" && !titlesPrinted[section]) {
             titlesPrinted[section] = 1;
+            var nodes = [makeSectionNode("/"+ section)];
+            if (isElement(section)) {
+                nodes.push(makeRemoveNode(section));
+            }
+            elems.push({
+                level: tokens.length - 1,
+                path: section,
+                nodes: nodes,
+            });
+        }
+
+        if (field.template) {
+            containers[field.path] = field;
+            titlesPrinted[field.path] = 1;
+            var button = field.kind === "ptr" ? makeOptionalNode(field) : makeAddNode(field);
             elems.push({
                 level: tokens.length - 1,
-                nodes: [makeSectionNode("/"+ section)],
+                path: field.path,
+                nodes: [makeSectionNode("/"+ field.path), button],
             });
+            continue;
         }
 
+        var nodes = makeFieldNode(field);
+        if (isElement(field.path)) {
+            nodes.push(makeRemoveNode(field.path));
+        } else if (field.optional) {
+            nodes.push(makeClearNode(nodes[1], field));
+        }
         elems.push({
             level: tokens.length - 1,
-            nodes: makeFieldNode(field),
+            path: field.path,
+            nodes: nodes,
         });
     }
 
-    render(elems);
+    return elems;
 }
 
-function render(elems) {
+// Renders rows before the given row, or at the end of the form
+function render(elems, before) {
     var fields = document.getElementById("fields");
 
     for (var i = 0; i < elems.length; i++) {
@@ -75,7 +140,76 @@ function render(elems) {
             nodes.unshift(makePaddingNode())
         }
 
-        fields.appendChild(makeRow(nodes));
+        fields.insertBefore(makeRow(nodes, row.path), before);
+    }
+}
+
+// Element path is a container path followed by an index or a key
+function isElement(path) {
+    var tokens = splitPath(path),
+        container = containers[joinPath(tokens.slice(0, -1))];
+    return tokens.length > 1 && container !== undefined && container.kind !== "ptr";
+}
+
+// Returns rows of the given field or section and all of its subfields
+function findRows(path) {
+    var rows = document.querySelectorAll("#fields .row"),
+        res = [];
+    for (var i = 0; i < rows.length; i++) {
+        var rpath = rows[i].getAttribute("data-path");
+        if (rpath === path || rpath.indexOf(path +".") === 0 || rpath.indexOf(path +"[") === 0) {
+            res.push(rows[i]);
+        }
+    }
+    return res;
+}
+
+function addElement(container) {
+    var key = "";
+    if (container.kind === "slice") {
+        // Next index is one more than the biggest existing one
+        var index = 0,
+            rows = findRows(container.path);
+        for (var i = 0; i < rows.length; i++) {
+            var tokens = splitPath(rows[i].getAttribute("data-path").substr(container.path.length));
+            if (tokens.length > 0 && tokens[0][0] === "[") {
+                index = Math.max(index, parseInt(tokens[0].slice(1, -1), 10) + 1);
+            }
+        }
+        key = "["+ index +"]";
+    } else if (container.kind === "map") {
+        key = window.prompt("Key");
+        if (!key) {
+            return;
+        }
+        key = keySuffix(key);
+    }
+
+    // Template paths are relative to the element
+    var fields = [];
+    for (var i = 0; i < container.template.length; i++) {
+        var field = Object.assign({}, container.template[i]),
+            rel = field.path;
+        if (rel !== "" && rel[0] !== "[") {
+            rel = "."+ rel;
+        }
+        field.path = container.path + key + rel;
+        fields.push(field);
+    }
+
+    var rows = findRows(container.path),
+        next = rows[rows.length - 1].nextSibling;
+    render(makeRows(fields, {}), next);
+}
+
+// Removes rows of an element, the title row is kept if keepTitle is set
+function removeElement(path, keepTitle) {
+    var rows = findRows(path);
+    for (var i = 0; i < rows.length; i++) {
+        if (keepTitle && rows[i].getAttribute("data-path") === path) {
+            continue;
+        }
+        rows[i].parentNode.removeChild(rows[i]);
     }
 }
 
@@ -85,9 +219,10 @@ function makePaddingNode() {
     return div;
 }
 
-function makeRow(nodes) {
+function makeRow(nodes, path) {
     var div = document.createElement("div");
     div.setAttribute("class", "row");
+    div.setAttribute("data-path", path);
 
     for (var i = 0; i < nodes.length; i++) {
         div.appendChild(nodes[i]);
@@ -101,10 +236,20 @@ function makeFieldNode(field) {
         label = makeLabelNode(field.path, field.name),
         input = document.createElement("input");
 
+    if (field.enum) {
+        var select = makeSelectNode(field);
+        pinField(select, field);
+        if (field.unset) {
+            setUnset(select, true);
+        }
+        return [label, select, makeResetNode(select, field)];
+    }
 
     input.setAttribute("id", field.path);
 
-    if (field.kind !== "bool") {
+    if (field.unset) {
+        setUnset(input, true);
+    } else if (field.kind !== "bool") {
         input.value = field.value;
     } else {
         if (field.value) {
@@ -113,10 +258,42 @@ function makeFieldNode(field) {
     }
 
     input.setAttribute("data-type", field.kind);
+    pinField(input, field);
+    if (field.required && field.kind !== "bool") {
+        input.setAttribute("required", "required");
+    }
 
     switch (field.kind) {
     case "string":
+        // Secret values come masked and are kept if the mask is submitted
+        input.setAttribute("type", field.secret ? "password" : "text");
+        if (field.min !== undefined) {
+            input.setAttribute("minlength", field.min);
+        }
+        if (field.max !== undefined) {
+            input.setAttribute("maxlength", field.max);
+        }
+        if (field.pattern) {
+            input.setAttribute("pattern", field.pattern);
+        }
+        formGroup.push(label);
+        formGroup.push(input);
+        break;
+    case "duration":
         input.setAttribute("type", "text");
+        input.setAttribute("pattern", "-?([0-9]*[.]?[0-9]+(ns|us|µs|ms|s|m|h))+|0");
+        if (!field.pinned_by) {
+            input.setAttribute("title", "Duration, e.g. 1h30m or 500ms");
+        }
+        formGroup.push(label);
+        formGroup.push(input);
+        break;
+    case "time":
+        input.setAttribute("type", "text");
+        input.setAttribute("pattern", "[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}([.][0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2})");
+        if (!field.pinned_by) {
+            input.setAttribute("title", "Time in RFC 3339 format, e.g. 2015-06-01T12:00:00Z");
+        }
         formGroup.push(label);
         formGroup.push(input);
         break;
@@ -180,16 +357,172 @@ function makeFieldNode(field) {
             input.setAttribute("step", "any");
             break;
         }
+        // Constraints from struct tags are narrower than type limits
+        if (field.min !== undefined) {
+            input.setAttribute("min", field.min);
+        }
+        if (field.max !== undefined) {
+            input.setAttribute("max", field.max);
+        }
         formGroup.push(label);
         formGroup.push(input);
         break;
     default:
         console.log("Invalid field type: "+ field.kind, field.path)
+        return formGroup;
     }
 
+    formGroup.push(makeResetNode(input, field));
     return formGroup;
 }
 
+function makeSelectNode(field) {
+    var select = document.createElement("select");
+    select.setAttribute("id", field.path);
+    select.setAttribute("data-type", field.kind);
+
+    for (var i = 0; i < field.enum.length; i++) {
+        var option = document.createElement("option"),
+            value = field.enum[i];
+        option.value = value;
+        option.appendChild(document.createTextNode(value));
+        if (String(field.value) === value) {
+            option.setAttribute("selected", "selected");
+        }
+        select.appendChild(option);
+    }
+
+    return select;
+}
+
+// Fields set by environment variables or flags can't be edited
+function pinField(input, field) {
+    if (field.pinned_by) {
+        input.setAttribute("disabled", "disabled");
+        input.setAttribute("title", "Set by "+ field.pinned_by);
+    }
+}
+
+// Reset button sets the input to the default value of the field
+function makeResetNode(input, field) {
+    var button = document.createElement("button"),
+        contents = document.createTextNode("Reset");
+    button.setAttribute("type", "button");
+    button.setAttribute("class", "reset");
+    button.setAttribute("title", "Default: "+ field.default);
+    button.appendChild(contents);
+    if (field.pinned_by) {
+        button.setAttribute("disabled", "disabled");
+    }
+
+    button.addEventListener("click", function() {
+        if (field.default === null) {
+            setUnset(input, true);
+        } else if (field.kind === "bool") {
+            setUnset(input, false);
+            input.checked = field.default;
+        } else {
+            setUnset(input, false);
+            input.value = field.default;
+        }
+    });
+
+    return button;
+}
+
+// Unset optional fields are saved as null until a value is entered
+function setUnset(input, unset) {
+    if (unset) {
+        input.setAttribute("data-unset", "1");
+        input.setAttribute("placeholder", "unset");
+        input.value = "";
+        input.checked = false;
+    } else {
+        input.removeAttribute("data-unset");
+        input.removeAttribute("placeholder");
+    }
+}
+
+// Clear button unsets an optional field
+function makeClearNode(input, field) {
+    var button = document.createElement("button");
+    button.setAttribute("type", "button");
+    button.setAttribute("class", "clear");
+    button.appendChild(document.createTextNode("Clear"));
+    if (field.pinned_by) {
+        button.setAttribute("disabled", "disabled");
+    }
+
+    input.addEventListener("input", function() {
+        setUnset(input, false);
+    });
+    input.addEventListener("change", function() {
+        setUnset(input, false);
+    });
+    button.addEventListener("click", function() {
+        setUnset(input, true);
+    });
+
+    return button;
+}
+
+// Optional section button either clears the section or adds its fields
+function makeOptionalNode(container) {
+    var button = document.createElement("button"),
+        contents = document.createTextNode(container.unset ? "Set" : "Clear");
+    button.setAttribute("type", "button");
+    button.setAttribute("class", "clear");
+    button.appendChild(contents);
+
+    button.addEventListener("click", function() {
+        if (container.unset) {
+            addElement(container);
+        } else {
+            removeElement(container.path, true);
+        }
+        container.unset = !container.unset;
+        contents.nodeValue = container.unset ? "Set" : "Clear";
+    });
+
+    return button;
+}
+
+function makeAddNode(container) {
+    var button = document.createElement("button");
+    button.setAttribute("type", "button");
+    button.setAttribute("class", "add");
+    button.appendChild(document.createTextNode("Add"));
+    button.addEventListener("click", function() {
+        addElement(container);
+    });
+    return button;
+}
+
+function makeRemoveNode(path) {
+    var button = document.createElement("button");
+    button.setAttribute("type", "button");
+    button.setAttribute("class", "remove");
+    button.appendChild(document.createTextNode("Remove"));
+    button.addEventListener("click", function() {
+        removeElement(path);
+    });
+    return button;
+}
+
+function markInvalid(errors) {
+    var marked = document.querySelectorAll(".invalid");
+    for (var i = 0; i < marked.length; i++) {
+        marked[i].classList.remove("invalid");
+    }
+
+    for (var i = 0; errors && i < errors.length; i++) {
+        var input = document.getElementById(errors[i].path);
+        if (input) {
+            input.classList.add("invalid");
+        }
+    }
+}
+
 function makeSectionNode(section) {
     var h2 = document.createElement("h2"),
         contents = document.createTextNode(section);
@@ -214,32 +547,68 @@ function makeLabelNode(forId, text) {
 function makePayload(elems) {
     var payload = {};
     for (path in elems) {
-        var value = elems[path],
-            tokens = path.split('.'),
-            parents = tokens.slice(0, -1),
-            key = tokens.slice(-1)[0],
-            parent = payload;
+        setPath(payload, splitPath(path), elems[path]);
+    }
 
-        for (var i = 0; i < parents.length; i++) {
-            var pkey = parents[i];
+    // Empty slices and maps are sent too, so that removing all of the
+    // elements is saved, and cleared optional sections are sent as null
+    for (path in containers) {
+        var container = containers[path],
+            empty = {};
+        if (container.kind === "slice") {
+            empty = [];
+        } else if (container.kind === "ptr" && container.unset) {
+            empty = null;
+        }
+        if (findRows(path).length > 0) {
+            setPath(payload, splitPath(path), empty, true);
+        }
+    }
 
-            if (!parent[pkey]) {
-                parent[pkey] = {}
+    return compact(payload);
+}
+
+function setPath(payload, tokens, value, keepExisting) {
+    var parent = payload;
+    for (var i = 0; i < tokens.length; i++) {
+        var key = tokenKey(tokens[i]);
+
+        if (i === tokens.length - 1) {
+            if (!keepExisting || parent[key] === undefined) {
+                parent[key] = value;
+            }
+        } else {
+            if (parent[key] === undefined) {
+                parent[key] = typeof tokenKey(tokens[i + 1]) === "number" ? [] : {};
             }
-            parent = parent[pkey];
+            parent = parent[key];
         }
-
-        parent[key] = value;
     }
+}
 
-    return payload;
+// Removed slice elements leave holes in arrays
+function compact(value) {
+    if (Array.isArray(value)) {
+        var res = [];
+        for (var i = 0; i < value.length; i++) {
+            if (value[i] !== undefined) {
+                res.push(compact(value[i]));
+            }
+        }
+        return res;
+    } else if (value !== null && typeof value === "object") {
+        for (var key in value) {
+            value[key] = compact(value[key]);
+        }
+    }
+    return value;
 }
 
 document.getElementById("config").addEventListener("submit", function(e){
     e.preventDefault();
 
     var elems = {},
-        inputs = document.getElementsByTagName("input");
+        inputs = document.querySelectorAll("input, select");
 
     for (var i = 0; i < inputs.length; i++) {
         var input = inputs[i],
@@ -247,8 +616,18 @@ document.getElementById("config").addEventListener("submit", function(e){
             path = input.getAttribute("id"),
             value = input.value;
 
+        if (input.disabled) {
+            continue;
+        }
+        if (input.hasAttribute("data-unset")) {
+            elems[path] = null;
+            continue;
+        }
+
         switch (type) {
         case "string":
+        case "duration":
+        case "time":
             elems[path] = value;
             break;
         case "bool":
@@ -274,8 +653,10 @@ document.getElementById("config").addEventListener("submit", function(e){
     }
 
     saveFields(makePayload(elems), function(resp){
+        markInvalid(resp.errors);
+
         var notice = document.getElementById("notice");
-        notice.innerHTML = resp.msg;
+        notice.textContent = resp.msg;
         if (resp.success) {
             notice.setAttribute("class", "success");
         } else {
@@ -0,0 +1,58 @@
+package secondly
+
+import (
+	"encoding"
+	"reflect"
+)
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Types that implement both encoding.TextMarshaler and
+// encoding.TextUnmarshaler, like net.IP, are edited as strings. All of the
+// built-in codecs use the same methods to read and write them.
+
+// isTextType returns true if values of the type can be converted to text and
+// back.
+func isTextType(typ reflect.Type) bool {
+	ptr := reflect.PtrTo(typ)
+	return (typ.Implements(textMarshalerType) || ptr.Implements(textMarshalerType)) &&
+		ptr.Implements(textUnmarshalerType)
+}
+
+// marshalText returns the text form of a value. It returns false if the value
+// can't be converted to text.
+func marshalText(val interface{}) (string, bool) {
+	if val == nil {
+		return "", false
+	}
+	tm, ok := val.(encoding.TextMarshaler)
+	if !ok {
+		// Method may have a pointer receiver
+		rval := reflect.ValueOf(val)
+		ptr := reflect.New(rval.Type())
+		ptr.Elem().Set(rval)
+		if tm, ok = ptr.Interface().(encoding.TextMarshaler); !ok {
+			return "", false
+		}
+	}
+
+	text, err := tm.MarshalText()
+	if err != nil {
+		return "", false
+	}
+
+	return string(text), true
+}
+
+// setText parses text into a settable value using its UnmarshalText method.
+// It returns false if the value doesn't have one.
+func setText(val reflect.Value, str string) (bool, error) {
+	if !isTextType(val.Type()) {
+		return false, nil
+	}
+
+	return true, val.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str))
+}
@@ -0,0 +1,111 @@
+package secondly
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type logLevel int
+
+const (
+	levelInfo logLevel = iota
+	levelDebug
+)
+
+func (l logLevel) MarshalText() ([]byte, error) {
+	switch l {
+	case levelInfo:
+		return []byte("info"), nil
+	case levelDebug:
+		return []byte("debug"), nil
+	default:
+		return nil, fmt.Errorf("unknown log level %d", l)
+	}
+}
+
+func (l *logLevel) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "info":
+		*l = levelInfo
+	case "debug":
+		*l = levelDebug
+	default:
+		return fmt.Errorf("unknown log level %q", text)
+	}
+	return nil
+}
+
+type textConf struct {
+	Listen net.IP   `json:"listen"`
+	Level  logLevel `json:"level" secondly:"enum=info|debug"`
+}
+
+func TestTextFields(t *testing.T) {
+	c1 := textConf{Listen: net.ParseIP("127.0.0.1"), Level: levelInfo}
+
+	fields := indexFields(formFields(&c1, "json"))
+	if f := fields["listen"]; f.Kind != "string" || f.Value != "127.0.0.1" {
+		t.Errorf("Expected listen to be a string field, got %+v", f)
+	}
+	if f := fields["level"]; f.Kind != "string" || f.Value != "info" {
+		t.Errorf("Expected level to be a string field, got %+v", f)
+	}
+
+	c2 := duplicate(&c1).(*textConf)
+	if err := setFields(c2, "json", map[string]string{"listen": "10.0.0.1", "level": "debug"}); err != nil {
+		t.Fatal(err)
+	}
+	if !c2.Listen.Equal(net.ParseIP("10.0.0.1")) || c2.Level != levelDebug {
+		t.Errorf("Unexpected config after setting fields: %+v", c2)
+	}
+	if err := setFields(c2, "json", map[string]string{"level": "trace"}); err == nil {
+		t.Error("Expected invalid level to fail")
+	}
+
+	d := diff(c1, *c2, "json")
+	if v := d["level"]; len(v) != 2 || v[0] != levelInfo || v[1] != levelDebug {
+		t.Errorf("Expected level to change from info to debug, got %v", v)
+	}
+	if _, ok := d["listen"]; !ok {
+		t.Error("Expected listen to change")
+	}
+	if err := checkConstraints(c2, "json"); err != nil {
+		t.Errorf("Expected enum constraint to use text values, got %v", err)
+	}
+}
+
+func TestSaveTextFields(t *testing.T) {
+	f := tempConfig(t, `{"listen": "127.0.0.1", "level": "info"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf textConf
+	m := testManager(f)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+
+	rw := httptest.NewRecorder()
+	m.saveHandler(rw, httptest.NewRequest("POST", "/save", strings.NewReader(`{"listen": "::1", "level": "debug"}`)))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("Expected save to succeed, got %d: %s", rw.Code, rw.Body.String())
+	}
+	if !conf.Listen.Equal(net.IPv6loopback) || conf.Level != levelDebug {
+		t.Errorf("Unexpected config after save: %+v", conf)
+	}
+
+	// Written file is read back with the same methods
+	var saved textConf
+	m2 := testManager(f)
+	if err := m2.Manage(&saved); err != nil {
+		t.Fatal(err)
+	}
+	if !saved.Listen.Equal(net.IPv6loopback) || saved.Level != levelDebug {
+		t.Errorf("Unexpected config read from the file: %+v", saved)
+	}
+}
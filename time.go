@@ -0,0 +1,243 @@
+package secondly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// Durations are written to config files as strings like "1m30s". YAML and
+// TOML libraries handle that on their own, while encoding/json only knows
+// integer nanoseconds, so JSON is converted with the help of a decoded tree.
+// Times need no special care: all of the built-in codecs use RFC 3339.
+
+// decodeJSON decodes JSON into the target. Durations may be either strings or
+// integer nanoseconds.
+func decodeJSON(body []byte, target interface{}) error {
+	typ := reflect.TypeOf(target)
+	if !hasDurations(typ, nil) {
+		return json.Unmarshal(body, target)
+	}
+
+	tree, err := jsonTree(body)
+	if err != nil {
+		return err
+	}
+	if tree, err = convertDurations(tree, typ, "json", parseDuration); err != nil {
+		return err
+	}
+	if body, err = json.Marshal(tree); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, target)
+}
+
+// encodeJSON encodes a config or a nested map of config values into JSON,
+// writing durations as strings.
+func encodeJSON(obj interface{}) ([]byte, error) {
+	if values, ok := obj.(map[string]interface{}); ok {
+		return json.Marshal(formatDurations(values))
+	}
+
+	typ := reflect.TypeOf(obj)
+	if !hasDurations(typ, nil) {
+		return json.Marshal(obj)
+	}
+
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := jsonTree(body)
+	if err != nil {
+		return nil, err
+	}
+	if tree, err = convertDurations(tree, typ, "json", formatDuration); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(tree)
+}
+
+// jsonTree decodes JSON into nested maps keeping numbers intact.
+func jsonTree(body []byte) (interface{}, error) {
+	var tree interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+// formatDurations replaces durations in nested maps and slices with strings.
+func formatDurations(tree interface{}) interface{} {
+	switch v := tree.(type) {
+	case time.Duration:
+		return v.String()
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = formatDurations(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = formatDurations(val)
+		}
+	}
+
+	return tree
+}
+
+// hasDurations returns true if values of the type contain durations.
+func hasDurations(typ reflect.Type, seen map[reflect.Type]bool) bool {
+	if typ == durationType {
+		return true
+	}
+	if seen[typ] {
+		return false
+	}
+	if seen == nil {
+		seen = make(map[reflect.Type]bool)
+	}
+	seen[typ] = true
+
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return hasDurations(typ.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if hasDurations(typ.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// convertDurations walks a decoded config tree along with the config type and
+// replaces duration values with the result of conv.
+func convertDurations(tree interface{}, typ reflect.Type, tagName string, conv func(val interface{}) (interface{}, error)) (interface{}, error) {
+	if typ == durationType {
+		return conv(tree)
+	}
+
+	var err error
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return convertDurations(tree, typ.Elem(), tagName, conv)
+	case reflect.Struct:
+		for i := 0; i < typ.NumField() && err == nil; i++ {
+			ftyp := typ.Field(i)
+			key, ok := fieldKey(ftyp, tagName)
+			if !ok {
+				continue
+			}
+			if key == "" {
+				// Fields of embedded structs are promoted
+				tree, err = convertDurations(tree, ftyp.Type, tagName, conv)
+				continue
+			}
+			if m, ok := tree.(map[string]interface{}); ok {
+				if val, ok := m[key]; ok {
+					m[key], err = convertDurations(val, ftyp.Type, tagName, conv)
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if s, ok := tree.([]interface{}); ok {
+			for i := 0; i < len(s) && err == nil; i++ {
+				s[i], err = convertDurations(s[i], typ.Elem(), tagName, conv)
+			}
+		}
+	case reflect.Map:
+		if m, ok := tree.(map[string]interface{}); ok {
+			for key, val := range m {
+				if m[key], err = convertDurations(val, typ.Elem(), tagName, conv); err != nil {
+					break
+				}
+			}
+		}
+	}
+
+	return tree, err
+}
+
+// parseDuration turns a duration string into integer nanoseconds.
+func parseDuration(val interface{}) (interface{}, error) {
+	str, ok := val.(string)
+	if !ok {
+		return val, nil
+	}
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return nil, err
+	}
+
+	return int64(d), nil
+}
+
+// formatDuration turns integer nanoseconds into a duration string.
+func formatDuration(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case time.Duration:
+		return v.String(), nil
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return nil, err
+		}
+		return time.Duration(n).String(), nil
+	default:
+		return val, nil
+	}
+}
+
+// formatValue returns a human-friendly representation of durations, times and
+// text types for the web GUI and flag defaults. Other values are returned as
+// is.
+func formatValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case time.Duration:
+		return v.String()
+	case time.Time:
+		return v.Format(time.RFC3339)
+	}
+	if text, ok := marshalText(val); ok {
+		return text
+	}
+
+	return val
+}
+
+// setTimeFromString parses durations and RFC 3339 times. It returns false if
+// the value is neither.
+func setTimeFromString(val reflect.Value, str string) (bool, error) {
+	switch val.Type() {
+	case durationType:
+		d, err := time.ParseDuration(str)
+		if err != nil {
+			return true, err
+		}
+		val.SetInt(int64(d))
+	case timeType:
+		t, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return true, fmt.Errorf("%q is not an RFC 3339 time", str)
+		}
+		val.Set(reflect.ValueOf(t))
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
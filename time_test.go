@@ -0,0 +1,115 @@
+package secondly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type timeConf struct {
+	Timeout  time.Duration            `json:"timeout" yaml:"timeout"`
+	Started  time.Time                `json:"started" yaml:"started"`
+	Retry    *time.Duration           `json:"retry" yaml:"retry"`
+	Backoffs []time.Duration          `json:"backoffs" yaml:"backoffs"`
+	Limits   map[string]time.Duration `json:"limits" yaml:"limits"`
+}
+
+func TestJSONDurations(t *testing.T) {
+	var conf timeConf
+	body := `{"timeout": "1m30s", "started": "2015-06-01T12:00:00Z", "retry": 5000000000, "backoffs": ["1s", "2s"], "limits": {"read": "500ms"}}`
+	if err := (jsonCodec{}).Decode([]byte(body), &conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Timeout != 90*time.Second {
+		t.Errorf("Expected timeout to be 1m30s, got %s", conf.Timeout)
+	}
+	if conf.Retry == nil || *conf.Retry != 5*time.Second {
+		t.Errorf("Expected retry to be 5s, got %v", conf.Retry)
+	}
+	if len(conf.Backoffs) != 2 || conf.Backoffs[1] != 2*time.Second || conf.Limits["read"] != 500*time.Millisecond {
+		t.Errorf("Unexpected durations in containers: %v, %v", conf.Backoffs, conf.Limits)
+	}
+	if !conf.Started.Equal(time.Date(2015, 6, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected start time: %s", conf.Started)
+	}
+
+	out, err := (jsonCodec{}).Encode(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, exp := range []string{`"timeout": "1m30s"`, `"retry": "5s"`, `"2s"`, `"read": "500ms"`, `"started": "2015-06-01T12:00:00Z"`} {
+		if !strings.Contains(string(out), exp) {
+			t.Errorf("Expected encoded config to contain %s, got %s", exp, out)
+		}
+	}
+
+	if err := (jsonCodec{}).Decode([]byte(`{"timeout": "soon"}`), &conf); err == nil {
+		t.Error("Expected invalid duration to fail decoding")
+	}
+}
+
+func TestTimeFields(t *testing.T) {
+	conf := timeConf{Timeout: time.Minute, Started: time.Date(2015, 6, 1, 12, 0, 0, 0, time.UTC)}
+
+	fields := indexFields(formFields(&conf, "json"))
+	if f := fields["timeout"]; f.Kind != "duration" || f.Value != "1m0s" {
+		t.Errorf("Expected timeout to be a duration of 1m0s, got %+v", f)
+	}
+	if f := fields["started"]; f.Kind != "time" || f.Value != "2015-06-01T12:00:00Z" {
+		t.Errorf("Expected started to be a time, got %+v", f)
+	}
+	if f := fields["retry"]; f.Kind != "duration" || !f.Unset {
+		t.Errorf("Expected retry to be an unset duration, got %+v", f)
+	}
+
+	err := setFields(&conf, "json", map[string]string{
+		"timeout": "2h",
+		"started": "2016-01-02T03:04:05+03:00",
+		"retry":   "10s",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conf.Timeout != 2*time.Hour || conf.Started.Year() != 2016 || *conf.Retry != 10*time.Second {
+		t.Errorf("Unexpected config after setting fields: %+v", conf)
+	}
+	if err := setFields(&conf, "json", map[string]string{"started": "yesterday"}); err == nil {
+		t.Error("Expected invalid time to fail")
+	}
+}
+
+func TestYAMLDurations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(file, []byte("timeout: 30s\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var conf timeConf
+	m := testManager(file)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Timeout != 30*time.Second {
+		t.Errorf("Expected timeout to be 30s, got %s", conf.Timeout)
+	}
+
+	conf.Timeout = time.Minute
+	if err := m.writeConfig(); err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), "timeout: 1m0s") {
+		t.Errorf("Expected timeout to be written as a string, got %s", body)
+	}
+}
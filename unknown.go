@@ -0,0 +1,146 @@
+package secondly
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// unknownKeysMode defines what happens when a config has keys that don't
+// match any of the struct fields.
+type unknownKeysMode int
+
+const (
+	ignoreUnknownKeys unknownKeysMode = iota
+	warnUnknownKeys
+	rejectUnknownKeys
+)
+
+// UnknownKeysError lists config keys that don't match any of the struct
+// fields.
+type UnknownKeysError []string
+
+func (e UnknownKeysError) Error() string {
+	return "Unknown config keys: " + strings.Join(e, ", ")
+}
+
+// checkLayerKeys looks for unknown keys in the contents of config files.
+// Files of codecs that can't decode generic trees are not checked.
+func (m *Manager) checkLayerKeys(layers []layer, bodies [][]byte) error {
+	if m.unknownKeys == ignoreUnknownKeys {
+		return nil
+	}
+
+	trees, err := decodeTrees(layers, bodies)
+	if err != nil {
+		return fmt.Errorf("Failed to check for unknown keys: %w", err)
+	}
+	var keys []string
+	for _, tree := range trees {
+		if tree != nil {
+			keys = append(keys, flattenKeys(tree, "")...)
+		}
+	}
+
+	return m.checkKeys(keys)
+}
+
+// warnUncheckedLayers warns about config files that can't be checked for
+// unknown keys because their codecs can't decode generic trees.
+func (m *Manager) warnUncheckedLayers(layers []layer) {
+	if m.unknownKeys == ignoreUnknownKeys {
+		return
+	}
+	for _, l := range layers {
+		if _, ok := l.cdc.(TreeCodec); !ok {
+			m.logger.Printf("Warning: Codec of %s can't decode generic trees, its keys are not checked\n", l.file)
+		}
+	}
+}
+
+// checkKeys reports keys that don't match any field of the config. In strict
+// mode an error is returned, in lenient mode a warning is reported.
+func (m *Manager) checkKeys(keys []string) error {
+	if m.unknownKeys == ignoreUnknownKeys {
+		return nil
+	}
+
+	// TOML decoder matches keys case-insensitively
+	tagName := m.codec().Tag()
+	norm := func(path string) string { return path }
+	if tagName == "toml" {
+		norm = strings.ToLower
+	}
+
+	known := make(map[string]bool)
+	var containers []string
+	var leaf, container visitFunc
+	leaf = func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		// Sections are known keys too
+		tokens := splitPath(path)
+		for i := range tokens {
+			known[norm(joinTokens(tokens[:i+1]))] = true
+		}
+	}
+	container = func(path string, ftyp reflect.StructField, fval reflect.Value) {
+		known[norm(path)] = true
+		if fval.Kind() != reflect.Ptr {
+			containers = append(containers, norm(path))
+		} else if fval.IsNil() {
+			// Fields of unset optional sections are known too
+			walkTree(reflect.New(fval.Type().Elem()), tagName, path, leaf, container)
+		}
+	}
+	walkTree(reflect.ValueOf(m.Snapshot()), tagName, "", leaf, container)
+
+	var unknown UnknownKeysError
+	for _, key := range keys {
+		if !known[norm(key)] && !inContainer(norm(key), containers) {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+
+	if m.unknownKeys == rejectUnknownKeys {
+		return unknown
+	}
+	m.reportWarning(unknown)
+
+	return nil
+}
+
+// inContainer returns true if the key is an element of one of the slices or
+// maps, which may have any number of elements.
+func inContainer(key string, containers []string) bool {
+	tokens := splitPath(key)
+	for _, c := range containers {
+		n := len(splitPath(c))
+		if len(tokens) > n && joinTokens(tokens[:n]) == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenKeys returns paths of all leaf keys of a nested map.
+func flattenKeys(obj interface{}, path string) []string {
+	var keys []string
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			keys = append(keys, flattenKeys(val, joinPath(path, key))...)
+		}
+	default:
+		return []string{path}
+	}
+	if len(keys) == 0 && path != "" {
+		return []string{path}
+	}
+
+	return keys
+}
@@ -0,0 +1,162 @@
+package secondly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+const misspelledJSON = `{"app_name": "Secondly", "databse": {"port": 3306}, "database": {"hots": "localhost"}}`
+
+func TestFlattenKeys(t *testing.T) {
+	keys := flattenKeys(map[string]interface{}{
+		"app_name": "Secondly",
+		"database": map[string]interface{}{
+			"host": "localhost",
+			"port": 3306,
+		},
+		"empty": map[string]interface{}{},
+	}, "")
+	sort.Strings(keys)
+
+	exp := []string{"app_name", "database.host", "database.port", "empty"}
+	if !reflect.DeepEqual(keys, exp) {
+		t.Errorf("Expected %v, got %v", exp, keys)
+	}
+}
+
+func TestStrictMode(t *testing.T) {
+	f := tempConfig(t, misspelledJSON)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	m := testManager(f)
+	Strict()(m)
+	err := m.Manage(&conf)
+	uerr, ok := err.(UnknownKeysError)
+	if !ok {
+		t.Fatalf("Expected unknown keys error, got %v", err)
+	}
+	exp := UnknownKeysError{"database.hots", "databse.port"}
+	if !reflect.DeepEqual(uerr, exp) {
+		t.Errorf("Expected %v, got %v", exp, uerr)
+	}
+}
+
+func TestLenientMode(t *testing.T) {
+	f := tempConfig(t, misspelledJSON)
+	defer os.RemoveAll(filepath.Dir(f))
+
+	var conf testConf
+	var warning error
+	m := testManager(f)
+	Lenient()(m)
+	m.OnWarning(func(err error) {
+		warning = err
+	})
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := warning.(UnknownKeysError); !ok {
+		t.Errorf("Expected unknown keys warning, got %v", warning)
+	}
+	if conf.AppName != "Secondly" {
+		t.Errorf("Expected config to be loaded, got %+v", conf)
+	}
+}
+
+type jsonOnlyConf struct {
+	AppName string `json:"app_name"`
+}
+
+func TestStrictModeOwnNaming(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "config.yaml")
+
+	// YAML decoder ignores json tags, so the key is not known
+	if err := ioutil.WriteFile(f, []byte("app_name: Secondly\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var conf jsonOnlyConf
+	m := testManager(f)
+	Strict()(m)
+	err = m.Manage(&conf)
+	if uerr, ok := err.(UnknownKeysError); !ok || len(uerr) != 1 || uerr[0] != "app_name" {
+		t.Errorf("Expected app_name to be unknown, got %v", err)
+	}
+
+	if err := ioutil.WriteFile(f, []byte("appname: Secondly\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m = testManager(f)
+	Strict()(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.AppName != "Secondly" {
+		t.Errorf("Expected app name to be loaded, got %q", conf.AppName)
+	}
+}
+
+type kvConf struct {
+	Host string `kv:"HOST"`
+	Port string `kv:"PORT"`
+}
+
+func TestStrictModeCustomCodec(t *testing.T) {
+	registerKVCodec(t)
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "config.kv")
+	if err := ioutil.WriteFile(f, []byte("HOST=localhost\nUSER=root\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Codec can't decode trees, so keys are not checked
+	var conf kvConf
+	m := testManager(f)
+	Strict()(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Host != "localhost" {
+		t.Errorf("Expected host to be loaded, got %q", conf.Host)
+	}
+}
+
+type untaggedConf struct {
+	Port int
+}
+
+func TestStrictModeTOMLCase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "config.toml")
+
+	// TOML decoder matches keys case-insensitively
+	if err := ioutil.WriteFile(f, []byte("port = 5\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var conf untaggedConf
+	m := testManager(f)
+	Strict()(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.Port != 5 {
+		t.Errorf("Expected port to be loaded, got %d", conf.Port)
+	}
+}
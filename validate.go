@@ -0,0 +1,37 @@
+package secondly
+
+import (
+	"fmt"
+)
+
+// Validator is implemented by configuration structs that can check their own
+// consistency. Validate is called on a candidate config before it replaces the
+// current one; a non-nil error rejects the reload.
+type Validator interface {
+	Validate() error
+}
+
+// validate checks field constraints, then runs the config's own Validate
+// method and all registered validators against a candidate config.
+func (m *Manager) validate(conf interface{}) error {
+	if err := checkConstraints(conf, m.codec().Tag()); err != nil {
+		return err
+	}
+	if v, ok := conf.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("Invalid config: %w", err)
+		}
+	}
+
+	m.mu.RLock()
+	validators := m.validators
+	m.mu.RUnlock()
+
+	for _, fun := range validators {
+		if err := fun(conf); err != nil {
+			return fmt.Errorf("Invalid config: %w", err)
+		}
+	}
+
+	return nil
+}
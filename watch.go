@@ -0,0 +1,172 @@
+package secondly
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/howeyc/fsnotify"
+)
+
+// watchDebounce is how long the watcher waits for more events before it
+// reloads the config. Editors and deployment tools often replace a file with a
+// burst of events: create, write, rename, chmod.
+const watchDebounce = 100 * time.Millisecond
+
+// fileWatcher reloads the config when any of the config files changes.
+//
+// Directories that contain config files are watched rather than the files
+// themselves, so that files replaced by rename, like editors and mv do, are
+// still noticed. Config files may be symlinks: directories of the files they
+// point to are watched too, and a change of the target, like Kubernetes swapping
+// the ..data symlink of a ConfigMap volume, also triggers a reload.
+type fileWatcher struct {
+	m     *Manager
+	fsw   *fsnotify.Watcher
+	dirs  map[string]bool   // dirs is the set of watched directories
+	files map[string]string // files maps absolute paths of config files to the files they resolve to
+	done  chan struct{}
+}
+
+func newFileWatcher(m *Manager) (*fileWatcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &fileWatcher{
+		m:     m,
+		fsw:   fsw,
+		dirs:  make(map[string]bool),
+		files: make(map[string]string),
+		done:  make(chan struct{}),
+	}
+	if err := w.update(); err != nil {
+		w.close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// update resolves config files and watches the directories they are in. It
+// is called after every change, as files and symlinks may have been replaced.
+func (w *fileWatcher) update() error {
+	files := make(map[string]string)
+	dirs := make(map[string]bool)
+	for _, l := range w.m.layerList() {
+		file, err := filepath.Abs(l.file)
+		if err != nil {
+			return err
+		}
+		files[file] = resolveFile(file)
+		dirs[filepath.Dir(file)] = true
+		dirs[filepath.Dir(files[file])] = true
+	}
+
+	for dir := range dirs {
+		if w.dirs[dir] {
+			continue
+		}
+		if err := w.fsw.WatchFlags(dir, fsnotify.FSN_ALL); err != nil {
+			return fmt.Errorf("Failed to watch %s: %w", dir, err)
+		}
+	}
+	for dir := range w.dirs {
+		if !dirs[dir] {
+			// Directory could have been removed already
+			w.fsw.RemoveWatch(dir)
+		}
+	}
+	w.dirs, w.files = dirs, files
+
+	return nil
+}
+
+// affects returns true if an event for the given path may change the config.
+func (w *fileWatcher) affects(name string) bool {
+	name = filepath.Clean(name)
+	for file, target := range w.files {
+		if name == file || name == target || resolveFile(file) != target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *fileWatcher) run() {
+	var debounce <-chan time.Time
+	for {
+		select {
+		case e, ok := <-w.fsw.Event:
+			if !ok {
+				return
+			}
+			if w.affects(e.Name) {
+				debounce = time.After(watchDebounce)
+			}
+		case <-debounce:
+			debounce = nil
+			if err := w.update(); err != nil {
+				w.m.reportError(err)
+			}
+			w.m.reloadChanged()
+		case err, ok := <-w.fsw.Error:
+			if !ok {
+				return
+			}
+			w.m.reportError(fmt.Errorf("fsnotify error: %w", err))
+		case <-w.done:
+			w.close()
+			return
+		}
+	}
+}
+
+func (w *fileWatcher) stop(ctx context.Context) error {
+	close(w.done)
+	return nil
+}
+
+// close closes the underlying watcher. Its channels are drained until they are
+// closed, so that its goroutines don't block on sending events nobody reads.
+// That happens in the background: the watcher only notices it is closed after
+// one more event, which never comes if watched directories are gone.
+func (w *fileWatcher) close() {
+	w.fsw.Close()
+	go func() {
+		events, errs := w.fsw.Event, w.fsw.Error
+		for events != nil || errs != nil {
+			select {
+			case _, ok := <-events:
+				if !ok {
+					events = nil
+				}
+			case _, ok := <-errs:
+				if !ok {
+					errs = nil
+				}
+			}
+		}
+	}()
+}
+
+// reloadChanged reloads the config after a config file has changed.
+func (m *Manager) reloadChanged() {
+	m.logger.Println("Config file was modified, reloading")
+	if err := m.readConfig(); err != nil {
+		m.reportError(err)
+	}
+}
+
+// resolveFile returns the path a file resolves to after following symlinks.
+// Missing files resolve to themselves.
+func resolveFile(file string) string {
+	if target, err := filepath.EvalSymlinks(file); err == nil {
+		return target
+	}
+
+	return file
+}
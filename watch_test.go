@@ -0,0 +1,148 @@
+package secondly
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer is a buffer that is safe to use from multiple goroutines.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// waitFor polls the condition until it is true or a second passes.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if cond() {
+			return
+		}
+	}
+	t.Fatalf("Timed out waiting for %s", what)
+}
+
+// startedManager loads the config file and starts watching it for changes
+// with the given function. The manager is stopped when the test ends.
+func startedManager(t *testing.T, file string, start func(m *Manager)) (*Manager, *syncBuffer) {
+	var conf testConf
+	var logs syncBuffer
+	m := testManager(file)
+	Logger(log.New(&logs, "", 0))(m)
+	if err := m.Manage(&conf); err != nil {
+		t.Fatal(err)
+	}
+	start(m)
+	t.Cleanup(m.Stop)
+
+	return m, &logs
+}
+
+func appName(m *Manager) string {
+	return m.Snapshot().(*testConf).AppName
+}
+
+func TestWatchReplacedFile(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "one"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+	m, _ := startedManager(t, f, (*Manager).HandleFileSystemEvents)
+
+	// Editors write a new file and rename it over the old one
+	tmp := f + ".swp"
+	if err := ioutil.WriteFile(tmp, []byte(`{"app_name": "two"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, f); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "replaced file to be reloaded", func() bool {
+		return appName(m) == "two"
+	})
+
+	// Watch survives the replacement
+	if err := ioutil.WriteFile(f, []byte(`{"app_name": "three"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "modified file to be reloaded", func() bool {
+		return appName(m) == "three"
+	})
+}
+
+func TestWatchSymlinkSwap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secondly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Same layout as a Kubernetes ConfigMap volume
+	version := func(name, body string) {
+		if err := os.Mkdir(filepath.Join(dir, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name, "config.json"), []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	version("..v1", `{"app_name": "one"}`)
+	if err := os.Symlink("..v1", filepath.Join(dir, "..data")); err != nil {
+		t.Skip("Symlinks are not supported:", err)
+	}
+	f := filepath.Join(dir, "config.json")
+	if err := os.Symlink(filepath.Join("..data", "config.json"), f); err != nil {
+		t.Fatal(err)
+	}
+	m, _ := startedManager(t, f, (*Manager).HandleFileSystemEvents)
+
+	version("..v2", `{"app_name": "two"}`)
+	if err := os.Symlink("..v2", filepath.Join(dir, "..data_tmp")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(filepath.Join(dir, "..v1")); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "symlink swap to be reloaded", func() bool {
+		return appName(m) == "two"
+	})
+}
+
+func TestWatchDebounce(t *testing.T) {
+	f := tempConfig(t, `{"app_name": "one"}`)
+	defer os.RemoveAll(filepath.Dir(f))
+	m, logs := startedManager(t, f, (*Manager).HandleFileSystemEvents)
+
+	for _, name := range []string{"two", "three", "four"} {
+		if err := ioutil.WriteFile(f, []byte(`{"app_name": "`+name+`"}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitFor(t, "config to be reloaded", func() bool {
+		return appName(m) == "four"
+	})
+	time.Sleep(2 * watchDebounce)
+
+	if n := strings.Count(logs.String(), "reloading"); n != 1 {
+		t.Errorf("Expected a single reload, got %d", n)
+	}
+}